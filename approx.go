@@ -0,0 +1,155 @@
+package ldapstrprep
+
+import (
+	"strings"
+)
+
+//ApproxMatcher reports whether two words of prepared values match approximately. See ApproxMatch.
+type ApproxMatcher interface {
+	MatchWord(a []rune, b []rune) bool
+}
+
+//ExactMatcher is the ApproxMatcher which matches equal words,
+//for the values whose approximate matching is equality matching, such as numbers.
+type ExactMatcher struct{}
+
+//MatchWord reports whether a and b are equal.
+func (ExactMatcher) MatchWord(a []rune, b []rune) bool {
+	return compareRunes(a, b) == 0
+}
+
+//LevenshteinMatcher is the ApproxMatcher which matches the words whose Levenshtein distance is MaxDistance or less,
+//such as misspelled words.
+type LevenshteinMatcher struct {
+	MaxDistance int
+}
+
+//MatchWord reports whether the Levenshtein distance between a and b is m.MaxDistance or less.
+//Distances are in code points.
+func (m LevenshteinMatcher) MatchWord(a []rune, b []rune) bool {
+	if len(a)-len(b) > m.MaxDistance || len(b)-len(a) > m.MaxDistance {
+		return false
+	}
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		least := cur[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			least = min(least, cur[j])
+		}
+		//The distance is not less than the least of a row.
+		if least > m.MaxDistance {
+			return false
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)] <= m.MaxDistance
+}
+
+//DoubleMetaphoneMatcher is the ApproxMatcher which matches the words which sound alike,
+//that is, the primary or alternate Double Metaphone codes of one are equal to those of the other.
+//Words which have other characters than Latin letters, such as digits, match if they are equal.
+//https://en.wikipedia.org/wiki/Metaphone#Double_Metaphone
+type DoubleMetaphoneMatcher struct{}
+
+//MatchWord reports whether a and b sound alike.
+func (DoubleMetaphoneMatcher) MatchWord(a []rune, b []rune) bool {
+	pa, aa, ok := doubleMetaphone(a)
+	if !ok {
+		return ExactMatcher{}.MatchWord(a, b)
+	}
+	pb, ab, ok := doubleMetaphone(b)
+	if !ok {
+		return false
+	}
+	return pa == pb || pa == ab || aa == pb || aa == ab
+}
+
+//ApproxMatch prepares value and assertion for the equality matching rule named rule, and reports whether they match
+//approximately under m, as an approxMatch filter (~=) does. The prepared values are split into words as
+//Insignificant Space Handling does, and each word of assertion must match a word of value in order.
+//Other words of value are ignored, so "john smith" matches "jon". An assertion without words matches only
+//a value without words.
+//https://tools.ietf.org/html/rfc4511#section-4.5.1.7.6
+func ApproxMatch(m ApproxMatcher, rule string, value string, assertion string) (bool, error) {
+	pv, err := Prepare(rule, value)
+	if err != nil {
+		return false, err
+	}
+	pa, err := Prepare(rule, assertion)
+	if err != nil {
+		return false, err
+	}
+	values := splitToWords(pv)
+	assertions := splitToWords(pa)
+	if len(assertions) == 0 {
+		return len(values) == 0, nil
+	}
+	i := 0
+	for _, v := range values {
+		if m.MatchWord(v, assertions[i]) {
+			if i++; i == len(assertions) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+//personalNameAttributes are the lower-cased names of the attribute types of the names of persons,
+//whose values are matched approximately by DoubleMetaphoneMatcher.
+//https://tools.ietf.org/html/rfc4519#section-2
+//https://tools.ietf.org/html/rfc2798#section-2.3
+var personalNameAttributes = map[string]struct{}{
+	"cn":          {},
+	"commonname":  {},
+	"sn":          {},
+	"surname":     {},
+	"givenname":   {},
+	"gn":          {},
+	"displayname": {},
+	"initials":    {},
+}
+
+//ApproxMatcher returns the ApproxMatcher of the attribute type attr in s.
+//It is the one set by SetApproxMatcher, or else:
+//DoubleMetaphoneMatcher for the names of persons, such as cn, sn and givenName;
+//ExactMatcher for the attribute types whose equality matching rules are numericStringMatch or telephoneNumberMatch;
+//LevenshteinMatcher whose MaxDistance is 1 for the others.
+func (s *Schema) ApproxMatcher(attr string) ApproxMatcher {
+	name := s.canonicalAttributeType(attr)
+	if m, ok := s.approxMatchers[name]; ok {
+		return m
+	}
+	if _, ok := personalNameAttributes[name]; ok {
+		return DoubleMetaphoneMatcher{}
+	}
+	switch strings.ToLower(s.EqualityRule(attr)) {
+	case strings.ToLower(NumericStringMatch), strings.ToLower(TelephoneNumberMatch):
+		return ExactMatcher{}
+	default:
+		return LevenshteinMatcher{MaxDistance: 1}
+	}
+}
+
+//SetApproxMatcher sets the ApproxMatcher of the attribute type attr in s to m.
+//attr is resolved as ApproxMatcher does, so it is set for all the names of attr which s knows.
+func (s *Schema) SetApproxMatcher(attr string, m ApproxMatcher) {
+	s.approxMatchers[s.canonicalAttributeType(attr)] = m
+}
+
+//ApproxMatch reports whether value of the attribute type attr matches assertion approximately,
+//with the ApproxMatcher of attr and its equality matching rule. See ApproxMatch.
+//An error is returned if the equality matching rule of attr is not supported, or either of the values cannot be prepared.
+func (s *Schema) ApproxMatch(attr string, value string, assertion string) (bool, error) {
+	return ApproxMatch(s.ApproxMatcher(attr), s.EqualityRule(attr), value, assertion)
+}
@@ -0,0 +1,153 @@
+package ldapstrprep
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLevenshteinMatcher_MatchWord(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name        string
+		maxDistance int
+		args        args
+		want        bool
+	}{
+		{"TestCase:equal", 0, args{"smith", "smith"}, true},
+		{"TestCase:substitution", 1, args{"smith", "smyth"}, true},
+		{"TestCase:insertion", 1, args{"smith", "smiith"}, true},
+		{"TestCase:deletion", 1, args{"smith", "smth"}, true},
+		{"TestCase:two edits", 1, args{"smith", "smyt"}, false},
+		{"TestCase:two edits within 2", 2, args{"smith", "smyt"}, true},
+		{"TestCase:length difference", 1, args{"smith", "sm"}, false},
+		{"TestCase:blank", 1, args{"", "a"}, true},
+		{"TestCase:non-ASCII", 1, args{"m\U000000FCller", "muller"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := LevenshteinMatcher{MaxDistance: tt.maxDistance}
+			if got := m.MatchWord([]rune(tt.args.a), []rune(tt.args.b)); got != tt.want {
+				t.Errorf("LevenshteinMatcher.MatchWord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDoubleMetaphoneMatcher_MatchWord(t *testing.T) {
+	type args struct {
+		a string
+		b string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"TestCase:primary codes", args{"stephen", "steven"}, true},
+		{"TestCase:primary and alternate codes", args{"smith", "schmidt"}, true},
+		{"TestCase:diacritics", args{"m\U000000FCller", "muller"}, true},
+		{"TestCase:different", args{"smith", "jones"}, false},
+		{"TestCase:digits", args{"123", "123"}, true},
+		{"TestCase:different digits", args{"123", "124"}, false},
+		{"TestCase:other script", args{"\U00005C71\U00007530", "\U00005C71\U00007530"}, true},
+		{"TestCase:Latin and other script", args{"yamada", "\U00005C71\U00007530"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (DoubleMetaphoneMatcher{}).MatchWord([]rune(tt.args.a), []rune(tt.args.b)); got != tt.want {
+				t.Errorf("DoubleMetaphoneMatcher.MatchWord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApproxMatch(t *testing.T) {
+	type args struct {
+		m         ApproxMatcher
+		rule      string
+		value     string
+		assertion string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"TestCase:phonetic", args{DoubleMetaphoneMatcher{}, CaseIgnoreMatch, "Stephen Smith", "Steven Smyth"}, true, false},
+		{"TestCase:words in order", args{DoubleMetaphoneMatcher{}, CaseIgnoreMatch, "John Q Smith", "jon smith"}, true, false},
+		{"TestCase:words out of order", args{DoubleMetaphoneMatcher{}, CaseIgnoreMatch, "John Smith", "smith john"}, false, false},
+		{"TestCase:more words than value", args{DoubleMetaphoneMatcher{}, CaseIgnoreMatch, "Smith", "john smith"}, false, false},
+		{"TestCase:prepared values", args{LevenshteinMatcher{MaxDistance: 1}, CaseIgnoreMatch, "\U0000FF2D\U0000FF41\U0000FF52\U0000FF59", "mery"}, true, false},
+		{"TestCase:Levenshtein", args{LevenshteinMatcher{MaxDistance: 1}, CaseIgnoreMatch, "Engineering", "enginering"}, true, false},
+		{"TestCase:exact numeric", args{ExactMatcher{}, NumericStringMatch, "1 234", "1234"}, true, false},
+		{"TestCase:exact numeric differs", args{ExactMatcher{}, NumericStringMatch, "1234", "1235"}, false, false},
+		{"TestCase:no words", args{ExactMatcher{}, CaseIgnoreMatch, " ", ""}, true, false},
+		{"TestCase:no words in assertion", args{ExactMatcher{}, CaseIgnoreMatch, "a", ""}, false, false},
+		{"TestCase:prohibited", args{ExactMatcher{}, CaseIgnoreMatch, "a\U0000E000", "a"}, false, true},
+		{"TestCase:unsupported rule", args{ExactMatcher{}, "integerMatch", "1", "1"}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ApproxMatch(tt.args.m, tt.args.rule, tt.args.value, tt.args.assertion)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ApproxMatch() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ApproxMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchema_ApproxMatcher(t *testing.T) {
+	s := NewSchema()
+	for _, def := range []string{
+		"( 2.5.4.41 NAME 'name' EQUALITY caseIgnoreMatch SUBSTR caseIgnoreSubstringsMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.15{32768} )",
+		"( 2.5.4.3 NAME ( 'cn' 'commonName' ) SUP name )",
+		"( 2.5.4.4 NAME ( 'sn' 'surname' ) SUP name )",
+		"( 2.5.4.11 NAME ( 'ou' 'organizationalUnitName' ) SUP name )",
+		"( 2.5.4.20 NAME 'telephoneNumber' EQUALITY telephoneNumberMatch SUBSTR telephoneNumberSubstringsMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.50 )",
+		"( 0.9.2342.19200300.100.1.1 NAME ( 'uid' 'userid' ) EQUALITY caseIgnoreMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.15 )",
+	} {
+		if err := s.AddAttributeType(def); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s.SetApproxMatcher("userid", ExactMatcher{})
+	tests := []struct {
+		name string
+		attr string
+		want ApproxMatcher
+	}{
+		{"TestCase:cn", "cn", DoubleMetaphoneMatcher{}},
+		{"TestCase:alias with option", "commonName;lang-en", DoubleMetaphoneMatcher{}},
+		{"TestCase:surname", "SURNAME", DoubleMetaphoneMatcher{}},
+		{"TestCase:ou", "ou", LevenshteinMatcher{MaxDistance: 1}},
+		{"TestCase:telephoneNumber", "telephoneNumber", ExactMatcher{}},
+		{"TestCase:set by alias", "uid", ExactMatcher{}},
+		{"TestCase:unknown", "description", LevenshteinMatcher{MaxDistance: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.ApproxMatcher(tt.attr); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Schema.ApproxMatcher() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+	t.Run("TestCase:ApproxMatch", func(t *testing.T) {
+		if got, err := s.ApproxMatch("cn", "Catherine Smith", "Kathryn Smyth"); err != nil || !got {
+			t.Errorf("Schema.ApproxMatch() = %v, %v, want true", got, err)
+		}
+		if got, err := s.ApproxMatch("telephoneNumber", "+1 512-315-0280", "+15123150281"); err != nil || got {
+			t.Errorf("Schema.ApproxMatch() = %v, %v, want false", got, err)
+		}
+		if _, err := s.ApproxMatch("description", "a", "a"); err == nil {
+			t.Errorf("Schema.ApproxMatch() error = nil, want error for an unknown attribute")
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package ldapstrprep
+
+import (
+	"encoding/asn1"
+	"fmt"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+//tagUniversalString is the ASN.1 tag of UniversalString, which is not defined by encoding/asn1.
+const tagUniversalString = 28
+
+//TranscodeASN1 transcodes raw, which is the content octets of an alternative of the ASN.1 DirectoryString CHOICE,
+//to slices of runes. tag is the universal tag number of the alternative:
+//asn1.TagT61String, asn1.TagPrintableString, 28 (UniversalString), asn1.TagUTF8String or asn1.TagBMPString.
+//TeletexString is decoded by DecodeT61, BMPString as UTF-16BE and UniversalString as UCS-4 (big-endian).
+//An InvalidEncodingError is returned if raw is not a valid encoding of the alternative.
+//https://tools.ietf.org/html/rfc4518#section-2.1
+func TranscodeASN1(tag int, raw []byte) ([]rune, error) {
+	switch tag {
+	case asn1.TagUTF8String:
+		return decodeUTF8String(raw)
+	case asn1.TagPrintableString:
+		return decodePrintableString(raw)
+	case asn1.TagT61String:
+		return DecodeT61(raw)
+	case asn1.TagBMPString:
+		return decodeBMPString(raw)
+	case tagUniversalString:
+		return decodeUniversalString(raw)
+	default:
+		return nil, fmt.Errorf("ldapstrprep: ASN.1 tag %d is not an alternative of DirectoryString", tag)
+	}
+}
+
+//decodeUTF8String decodes raw as UTF8String.
+func decodeUTF8String(raw []byte) ([]rune, error) {
+	dst := make([]rune, 0, len(raw))
+	for i := 0; i < len(raw); {
+		r, size := utf8.DecodeRune(raw[i:])
+		if r == utf8.RuneError && size == 1 {
+			return nil, &InvalidEncodingError{Encoding: "UTF8String", Offset: i}
+		}
+		dst = append(dst, r)
+		i += size
+	}
+	return dst, nil
+}
+
+//decodePrintableString decodes raw as PrintableString.
+func decodePrintableString(raw []byte) ([]rune, error) {
+	dst := make([]rune, 0, len(raw))
+	for i, c := range raw {
+		if !isPrintableCharacter(c) {
+			return nil, &InvalidEncodingError{Encoding: "PrintableString", Offset: i}
+		}
+		dst = append(dst, rune(c))
+	}
+	return dst, nil
+}
+
+//decodeBMPString decodes raw as BMPString, which is UTF-16BE.
+func decodeBMPString(raw []byte) ([]rune, error) {
+	if len(raw)%2 != 0 {
+		return nil, &InvalidEncodingError{Encoding: "BMPString", Offset: len(raw) - 1}
+	}
+	dst := make([]rune, 0, len(raw)/2)
+	for i := 0; i < len(raw); i += 2 {
+		r := rune(raw[i])<<8 | rune(raw[i+1])
+		if utf16.IsSurrogate(r) {
+			var r2 rune = utf8.RuneError
+			if i+3 < len(raw) {
+				r2 = rune(raw[i+2])<<8 | rune(raw[i+3])
+			}
+			if r = utf16.DecodeRune(r, r2); r == utf8.RuneError {
+				return nil, &InvalidEncodingError{Encoding: "BMPString", Offset: i}
+			}
+			i += 2
+		}
+		dst = append(dst, r)
+	}
+	return dst, nil
+}
+
+//decodeUniversalString decodes raw as UniversalString, which is UCS-4 (big-endian).
+func decodeUniversalString(raw []byte) ([]rune, error) {
+	if len(raw)%4 != 0 {
+		return nil, &InvalidEncodingError{Encoding: "UniversalString", Offset: len(raw) - len(raw)%4}
+	}
+	dst := make([]rune, 0, len(raw)/4)
+	for i := 0; i < len(raw); i += 4 {
+		c := uint32(raw[i])<<24 | uint32(raw[i+1])<<16 | uint32(raw[i+2])<<8 | uint32(raw[i+3])
+		if c > utf8.MaxRune || utf16.IsSurrogate(rune(c)) {
+			return nil, &InvalidEncodingError{Encoding: "UniversalString", Offset: i}
+		}
+		dst = append(dst, rune(c))
+	}
+	return dst, nil
+}
@@ -0,0 +1,52 @@
+package ldapstrprep
+
+import (
+	"encoding/asn1"
+	"reflect"
+	"testing"
+)
+
+func TestTranscodeASN1(t *testing.T) {
+	type args struct {
+		tag int
+		raw []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []rune
+		wantErr bool
+	}{
+		{"TestCase:UTF8String", args{asn1.TagUTF8String, []byte("日本 Corp")}, []rune("日本 Corp"), false},
+		{"TestCase:UTF8String empty", args{asn1.TagUTF8String, []byte{}}, []rune{}, false},
+		{"TestCase:UTF8String invalid", args{asn1.TagUTF8String, []byte{'a', 0XC3, 0X28}}, nil, true},
+		{"TestCase:UTF8String surrogate", args{asn1.TagUTF8String, []byte{0XED, 0XA0, 0X80}}, nil, true},
+		{"TestCase:PrintableString", args{asn1.TagPrintableString, []byte("Acme, Inc.")}, []rune("Acme, Inc."), false},
+		{"TestCase:PrintableString invalid", args{asn1.TagPrintableString, []byte("a@b")}, nil, true},
+		{"TestCase:TeletexString", args{asn1.TagT61String, []byte{'M', 0XC8, 'u', 'n'}}, []rune("Mu\U00000308n"), false},
+		{"TestCase:TeletexString invalid", args{asn1.TagT61String, []byte{'M', 0XC8}}, nil, true},
+		{"TestCase:BMPString", args{asn1.TagBMPString, []byte{0X00, 0X41, 0X65, 0XE5}}, []rune("A\U000065E5"), false},
+		{"TestCase:BMPString surrogate pair", args{asn1.TagBMPString, []byte{0XD8, 0X40, 0XDC, 0X00}}, []rune("\U00020000"), false},
+		{"TestCase:BMPString odd length", args{asn1.TagBMPString, []byte{0X00, 0X41, 0X00}}, nil, true},
+		{"TestCase:BMPString unpaired high surrogate", args{asn1.TagBMPString, []byte{0XD8, 0X40, 0X00, 0X41}}, nil, true},
+		{"TestCase:BMPString high surrogate at end", args{asn1.TagBMPString, []byte{0X00, 0X41, 0XD8, 0X40}}, nil, true},
+		{"TestCase:BMPString unpaired low surrogate", args{asn1.TagBMPString, []byte{0XDC, 0X00}}, nil, true},
+		{"TestCase:UniversalString", args{28, []byte{0X00, 0X00, 0X00, 0X41, 0X00, 0X02, 0X00, 0X00}}, []rune("A\U00020000"), false},
+		{"TestCase:UniversalString invalid length", args{28, []byte{0X00, 0X00, 0X41}}, nil, true},
+		{"TestCase:UniversalString out of range", args{28, []byte{0X00, 0X11, 0X00, 0X00}}, nil, true},
+		{"TestCase:UniversalString surrogate", args{28, []byte{0X00, 0X00, 0XD8, 0X00}}, nil, true},
+		{"TestCase:IA5String is not an alternative", args{asn1.TagIA5String, []byte("a")}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TranscodeASN1(tt.args.tag, tt.args.raw)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TranscodeASN1() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TranscodeASN1() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/tardevnull/ldapstrprep"
+)
+
+//runDuplicates runs the duplicates subcommand, which reports the result of Schema.FindDuplicates.
+func runDuplicates(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	fs := flag.NewFlagSet("duplicates", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var schemaFiles stringsFlag
+	fs.Var(&schemaFiles, "schema", "schema file in LDIF or OpenLDAP format (repeatable)")
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	schema, err := ldapstrprep.LoadSchemaFiles(schemaFiles...)
+	if err != nil {
+		fmt.Fprintf(stderr, "ldapstrprep: %v\n", err)
+		return exitError
+	}
+	recs, err := readRecords(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "ldapstrprep: %v\n", err)
+		return exitError
+	}
+	r := schema.FindDuplicates(recs)
+	for _, g := range r.DNs {
+		fmt.Fprintf(stdout, "duplicate DN %q:\n", g.Key)
+		for _, o := range g.Occurrences {
+			fmt.Fprintf(stdout, "  line %d: %s\n", o.Line, o.DN)
+		}
+	}
+	for _, g := range r.Values {
+		fmt.Fprintf(stdout, "duplicate values of %s (%s) in %q:\n", g.Attribute, g.Rule, g.Occurrences[0].DN)
+		for _, o := range g.Occurrences {
+			fmt.Fprintf(stdout, "  line %d: %q\n", o.Line, o.Value)
+		}
+	}
+	for _, g := range r.Collisions {
+		fmt.Fprintf(stdout, "colliding values of %s (%s):\n", g.Attribute, g.Rule)
+		for _, o := range g.Occurrences {
+			fmt.Fprintf(stdout, "  line %d: %q in %q\n", o.Line, o.Value, o.DN)
+		}
+	}
+	if r.HasDuplicates() {
+		return exitFound
+	}
+	return exitOK
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRunDuplicates(t *testing.T) {
+	schema := writeFile(t, "schema.ldif", testSchemaLDIF)
+	entries := writeFile(t, "entries.ldif", "dn: cn=John  Smith,o=Example\ncn: John  Smith\ncn: john smith\nsn: ＡＢＣ\n\ndn: CN=john smith,o=Example\ncn: Bar\nsn: abc\n")
+	type args struct {
+		args  []string
+		stdin string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantStatus int
+		wantStdout string
+	}{
+		{"TestCase:Duplicates are found", args{[]string{"duplicates", "--schema", schema, entries}, ""}, exitFound, `duplicate DN "cn=john smith,o=Example":
+  line 1: cn=John  Smith,o=Example
+  line 6: CN=john smith,o=Example
+duplicate values of cn (caseIgnoreMatch) in "cn=John  Smith,o=Example":
+  line 1: "John  Smith"
+  line 1: "john smith"
+colliding values of sn (caseIgnoreMatch):
+  line 1: "ＡＢＣ" in "cn=John  Smith,o=Example"
+  line 6: "abc" in "CN=john smith,o=Example"
+`},
+		{"TestCase:Nothing is found in stdin", args{[]string{"duplicates", "--schema", schema}, "dn: cn=Foo\ncn: Foo\n"}, exitOK, ""},
+		{"TestCase:Without schema", args{[]string{"duplicates"}, "dn: cn=Foo\ncn: Foo\ncn: FOO\n"}, exitOK, ""},
+		{"TestCase:Invalid LDIF", args{[]string{"duplicates"}, "cn: Foo\n"}, exitError, ""},
+		{"TestCase:Missing schema file", args{[]string{"duplicates", "--schema", schema + ".missing"}, ""}, exitError, ""},
+		{"TestCase:Invalid flag", args{[]string{"duplicates", "--foo"}, ""}, exitError, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			if got := run(tt.args.args, strings.NewReader(tt.args.stdin), &stdout, &stderr); got != tt.wantStatus {
+				t.Errorf("run() = %v, want %v, stderr = %s", got, tt.wantStatus, stderr.String())
+			}
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("run() stdout = %q, want %q", stdout.String(), tt.wantStdout)
+			}
+		})
+	}
+}
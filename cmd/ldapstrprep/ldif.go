@@ -0,0 +1,152 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/tardevnull/ldapstrprep"
+	"github.com/tardevnull/ldapstrprep/ldif"
+)
+
+//runLDIF runs the ldif subcommand, whose first argument is the subcommand of it.
+func runLDIF(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitError
+	}
+	switch args[0] {
+	case "normalize":
+		return runLDIFNormalize(args[1:], stdin, stdout, stderr)
+	default:
+		fmt.Fprintf(stderr, "ldapstrprep: unknown ldif subcommand %q\n%s", args[0], usage)
+		return exitError
+	}
+}
+
+//ldifNormalizer normalizes LDIF records.
+type ldifNormalizer struct {
+	schema *ldapstrprep.Schema
+	strip  bool
+	stderr io.Writer
+	//found reports whether a prohibited character has been found.
+	found bool
+}
+
+//ldifProblem is the position of a problem in a LDIF file, which is reported with the DN as it is written.
+type ldifProblem struct {
+	file string
+	dn   string
+}
+
+//runLDIFNormalize runs the ldif normalize subcommand, which writes the records of LDIF with the canonical DNs.
+//The version line is written if any input has it. Prohibited characters are reported with the file name,
+//the line of the value and the DN as it is written, and the exit status is exitFound if they are found.
+func runLDIFNormalize(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	fs := flag.NewFlagSet("ldif normalize", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var schemaFiles stringsFlag
+	fs.Var(&schemaFiles, "schema", "schema file in LDIF or OpenLDAP format (repeatable)")
+	strip := fs.Bool("strip", false, "remove the characters which are mapped to nothing, such as control codes, from values")
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	schema, err := ldapstrprep.LoadSchemaFiles(schemaFiles...)
+	if err != nil {
+		fmt.Fprintf(stderr, "ldapstrprep: %v\n", err)
+		return exitError
+	}
+	lfs, err := readLDIFFiles(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "ldapstrprep: %v\n", err)
+		return exitError
+	}
+	n := &ldifNormalizer{schema: schema, strip: *strip, stderr: stderr}
+	recs := make([]*ldif.Record, 0, 0)
+	w := ldif.NewWriter(stdout)
+	for _, lf := range lfs {
+		for _, rec := range lf.recs {
+			n.normalize(lf.name, rec)
+		}
+		recs = append(recs, lf.recs...)
+		w.Version = w.Version || lf.version == 1
+	}
+	if err := w.WriteAll(recs); err != nil {
+		fmt.Fprintf(stderr, "ldapstrprep: %v\n", err)
+		return exitError
+	}
+	if n.found {
+		return exitFound
+	}
+	return exitOK
+}
+
+//normalize checks and strips the values of rec read from file, and rewrites the DNs of rec into the canonical form.
+//Problems of newrdn and newsuperior are reported at the line where rec starts, because ldif.Record does not keep their lines.
+func (n *ldifNormalizer) normalize(file string, rec *ldif.Record) {
+	p := ldifProblem{file: file, dn: rec.DN}
+	for _, a := range rec.Attributes {
+		n.normalizeValues(p, a.Type, a.Values, a.Lines)
+	}
+	for _, m := range rec.Modifications {
+		n.normalizeValues(p, m.Type, m.Values, m.Lines)
+	}
+	rec.DN = n.canonicalDN(p, rec.Line, "dn", rec.DN)
+	if rec.NewSuperior != "" {
+		rec.NewSuperior = n.canonicalDN(p, rec.Line, "newsuperior", rec.NewSuperior)
+	}
+	if rec.NewRDN != "" {
+		rec.NewRDN = n.canonicalDN(p, rec.Line, "newrdn", rec.NewRDN)
+	}
+}
+
+//canonicalDN returns the canonical form of dn, which is the value of field at line.
+//dn is returned as it is if it cannot be canonicalized.
+func (n *ldifNormalizer) canonicalDN(p ldifProblem, line int, field string, dn string) string {
+	c, err := n.schema.CanonicalDN(dn)
+	if err != nil {
+		n.report(p, line, field, err)
+		return dn
+	}
+	return c
+}
+
+//normalizeValues checks whether values of the attribute attr, which start at lines, have prohibited characters,
+//and strips them if n.strip is true. Values whose equality matching rules are not supported are skipped.
+func (n *ldifNormalizer) normalizeValues(p ldifProblem, attr string, values []string, lines []int) {
+	rule := n.schema.EqualityRule(attr)
+	if !ldapstrprep.SupportsRule(rule) {
+		return
+	}
+	for i, v := range values {
+		if n.strip {
+			values[i] = stripCharacters(v)
+		}
+		if _, err := ldapstrprep.Prepare(rule, values[i]); err != nil {
+			n.report(p, lines[i], attr, err)
+		}
+	}
+}
+
+//report reports err of the field at line.
+func (n *ldifNormalizer) report(p ldifProblem, line int, field string, err error) {
+	n.found = true
+	fmt.Fprintf(n.stderr, "%s:%d: %s: %s: %v\n", p.file, line, p.dn, field, err)
+}
+
+//stripCharacters removes the characters which MapCharacters maps to nothing from s.
+//Invalid UTF-8 sequences are kept.
+//https://tools.ietf.org/html/rfc4518#section-2.2
+func stripCharacters(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if (r == utf8.RuneError && size == 1) || len(ldapstrprep.MapCharacters([]rune{r}, false)) != 0 {
+			b.WriteString(s[i : i+size])
+		}
+		i += size
+	}
+	return b.String()
+}
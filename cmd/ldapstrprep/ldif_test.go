@@ -0,0 +1,47 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRunLDIFNormalize(t *testing.T) {
+	schema := writeFile(t, "schema.ldif", testSchemaLDIF)
+	foo := writeFile(t, "foo.ldif", "version: 1\ndn: CN=Foo\ncn: Foo\n")
+	bar := writeFile(t, "bar.ldif", "dn: CN=Bar\ncn: Bar\ncn:: 77+9\n")
+	type args struct {
+		args  []string
+		stdin string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantStatus int
+		wantStdout string
+		wantStderr string
+	}{
+		{"TestCase:DNs are canonicalized", args{[]string{"ldif", "normalize", "--schema", schema}, "version: 1\ndn: CN=John  SMITH,o=Example\ncn: John  SMITH\n\ndn: cn=Foo\nchangetype: modrdn\nnewrdn: CN=ＢＡＲ\ndeleteoldrdn: 1\nnewsuperior: CN=Baz\n"}, exitOK, "version: 1\ndn: cn=john smith,o=Example\ncn: John  SMITH\n\ndn: cn=foo\nchangetype: modrdn\nnewrdn: cn=bar\ndeleteoldrdn: 1\nnewsuperior: cn=baz\n", ""},
+		{"TestCase:Prohibited characters are reported", args{[]string{"ldif", "normalize", "--schema", schema}, "dn: cn=Foo\ncn: Foo\nsn:: 77+9\n\ndn: cn=Bar\nchangetype: modify\nreplace: sn\nsn:: 77+9\n-\n"}, exitFound, "dn: cn=foo\ncn: Foo\nsn:: 77+9\n\ndn: cn=bar\nchangetype: modify\nreplace: sn\nsn:: 77+9\n-\n", "-:3: cn=Foo: sn: ldapstrprep: U+FFFD '�' is prohibit character\n-:8: cn=Bar: sn: ldapstrprep: U+FFFD '�' is prohibit character\n"},
+		{"TestCase:Prohibited characters in DN", args{[]string{"ldif", "normalize", "--schema", schema}, "dn:: Y249Zm/vv71v\ncn: Foo\n"}, exitFound, "dn:: Y249Zm/vv71v\ncn: Foo\n", "-:1: cn=fo�o: dn: ldapstrprep: DN \"cn=fo�o\": attribute cn: ldapstrprep: U+FFFD '�' is prohibit character\n"},
+		{"TestCase:Strip", args{[]string{"ldif", "normalize", "--schema", schema, "--strip"}, "dn: cn=Foo\ncn:: Rm8AbwY=\njpegPhoto:: AAEC\n"}, exitOK, "dn: cn=foo\ncn: Foo\njpegPhoto:: AAEC\n", ""},
+		{"TestCase:Without strip", args{[]string{"ldif", "normalize", "--schema", schema}, "dn: cn=Foo\ncn:: Rm8Abw==\n"}, exitOK, "dn: cn=foo\ncn:: Rm8Abw==\n", ""},
+		{"TestCase:Files", args{[]string{"ldif", "normalize", "--schema", schema, foo, bar}, ""}, exitFound, "version: 1\ndn: cn=foo\ncn: Foo\n\ndn: cn=bar\ncn: Bar\ncn:: 77+9\n", bar + ":3: CN=Bar: cn: ldapstrprep: U+FFFD '�' is prohibit character\n"},
+		{"TestCase:No ldif subcommand", args{[]string{"ldif"}, ""}, exitError, "", usage},
+		{"TestCase:Unknown ldif subcommand", args{[]string{"ldif", "sort"}, ""}, exitError, "", "ldapstrprep: unknown ldif subcommand \"sort\"\n" + usage},
+		{"TestCase:Invalid LDIF", args{[]string{"ldif", "normalize"}, "cn: Foo\n"}, exitError, "", "ldapstrprep: ldif: line 1: record starts with \"cn\" instead of dn\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			if got := run(tt.args.args, strings.NewReader(tt.args.stdin), &stdout, &stderr); got != tt.wantStatus {
+				t.Errorf("run() = %v, want %v, stderr = %s", got, tt.wantStatus, stderr.String())
+			}
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("run() stdout = %q, want %q", stdout.String(), tt.wantStdout)
+			}
+			if stderr.String() != tt.wantStderr {
+				t.Errorf("run() stderr = %q, want %q", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
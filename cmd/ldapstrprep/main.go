@@ -0,0 +1,140 @@
+//Command ldapstrprep prepares LDAP values by the string preparation algorithms described in RFC 4518.
+/*
+
+Usage:
+
+  ldapstrprep [prepare] [--rule caseIgnore|caseExact|numeric|telephone] [--substr initial|any|final]
+      [--output text|hex|json] [--trace] [--check-prohibited] [value]...
+  ldapstrprep duplicates [--schema file]... [file.ldif]...
+  ldapstrprep ldif normalize [--schema file]... [--strip] [file.ldif]...
+
+Subcommands:
+
+  prepare     prepares the values, or the lines of the standard input if no value is given.
+              --trace writes the value after each step, and --check-prohibited only checks prohibited characters.
+  duplicates  reports the DNs and values of LDIF entries which collide once prepared for the equality matching rules.
+  ldif normalize
+              writes LDIF whose DNs are rewritten into the canonical form, and reports prohibited characters of values
+              with the file name and the line of the value. --strip removes the characters which are mapped to nothing,
+              such as control codes, from values.
+
+The prepare subcommand is run if the first argument is a flag.
+
+Exit status is 0 on success, and 2 if an error occurred. It is 1 if a value cannot be prepared or has prohibited
+characters for prepare and ldif normalize, and if duplicates are found for duplicates.
+
+*/
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/tardevnull/ldapstrprep/ldif"
+)
+
+//Exit statuses.
+const (
+	exitOK    = 0
+	exitFound = 1
+	exitError = 2
+)
+
+const usage = `usage: ldapstrprep <subcommand> [arguments]
+
+subcommands:
+  prepare [--rule caseIgnore|caseExact|numeric|telephone] [--substr initial|any|final]
+      [--output text|hex|json] [--trace] [--check-prohibited] [value]...
+  duplicates [--schema file]... [file.ldif]...
+  ldif normalize [--schema file]... [--strip] [file.ldif]...
+`
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+//run runs the subcommand args[0] and returns the exit status.
+func run(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprint(stderr, usage)
+		return exitError
+	}
+	if strings.HasPrefix(args[0], "-") && args[0] != "-h" && args[0] != "--help" {
+		return runPrepare(args, stdin, stdout, stderr)
+	}
+	switch args[0] {
+	case "prepare":
+		return runPrepare(args[1:], stdin, stdout, stderr)
+	case "duplicates":
+		return runDuplicates(args[1:], stdin, stdout, stderr)
+	case "ldif":
+		return runLDIF(args[1:], stdin, stdout, stderr)
+	case "-h", "--help", "help":
+		fmt.Fprint(stdout, usage)
+		return exitOK
+	default:
+		fmt.Fprintf(stderr, "ldapstrprep: unknown subcommand %q\n%s", args[0], usage)
+		return exitError
+	}
+}
+
+//stringsFlag is a flag which may be given more than once.
+type stringsFlag []string
+
+func (f *stringsFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *stringsFlag) Set(s string) error {
+	*f = append(*f, s)
+	return nil
+}
+
+//ldifFile is the records read from a LDIF file. name is "-" for the standard input.
+type ldifFile struct {
+	name    string
+	recs    []*ldif.Record
+	version int
+}
+
+//readLDIFFiles reads the LDIF files, or stdin if files are empty.
+func readLDIFFiles(files []string, stdin io.Reader) ([]ldifFile, error) {
+	if len(files) == 0 {
+		r := ldif.NewReader(stdin)
+		recs, err := r.ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		return []ldifFile{{"-", recs, r.Version()}}, nil
+	}
+	dst := make([]ldifFile, 0, len(files))
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		r := ldif.NewReader(f)
+		recs, err := r.ReadAll()
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		dst = append(dst, ldifFile{name, recs, r.Version()})
+	}
+	return dst, nil
+}
+
+//readRecords reads the records of the LDIF files, or of stdin if files are empty.
+func readRecords(files []string, stdin io.Reader) ([]*ldif.Record, error) {
+	lfs, err := readLDIFFiles(files, stdin)
+	if err != nil {
+		return nil, err
+	}
+	recs := make([]*ldif.Record, 0, 0)
+	for _, lf := range lfs {
+		recs = append(recs, lf.recs...)
+	}
+	return recs, nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//writeFile writes content to the file named name in a temporary directory, and returns the path.
+func writeFile(t *testing.T, name string, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+const testSchemaLDIF = `dn: cn=schema
+attributeTypes: ( 2.5.4.41 NAME 'name' EQUALITY caseIgnoreMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.15 )
+attributeTypes: ( 2.5.4.3 NAME 'cn' SUP name )
+attributeTypes: ( 2.5.4.4 NAME 'sn' SUP name )
+`
+
+func TestRun(t *testing.T) {
+	type args struct {
+		args  []string
+		stdin string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantStatus int
+		wantStdout string
+	}{
+		{"TestCase:No subcommand", args{[]string{}, ""}, exitError, ""},
+		{"TestCase:Unknown subcommand", args{[]string{"foo"}, ""}, exitError, ""},
+		{"TestCase:Help", args{[]string{"help"}, ""}, exitOK, usage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			if got := run(tt.args.args, strings.NewReader(tt.args.stdin), &stdout, &stderr); got != tt.wantStatus {
+				t.Errorf("run() = %v, want %v, stderr = %s", got, tt.wantStatus, stderr.String())
+			}
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("run() stdout = %q, want %q", stdout.String(), tt.wantStdout)
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tardevnull/ldapstrprep"
+)
+
+//cliRule is a matching rule which can be given by --rule.
+type cliRule struct {
+	//equality and substrings are the names of the matching rules.
+	equality   string
+	substrings string
+}
+
+//cliRules maps the values of --rule to the matching rules.
+var cliRules = map[string]cliRule{
+	"caseIgnore": {ldapstrprep.CaseIgnoreMatch, ldapstrprep.CaseIgnoreSubstringsMatch},
+	"caseExact":  {ldapstrprep.CaseExactMatch, ldapstrprep.CaseExactSubstringsMatch},
+	"numeric":    {ldapstrprep.NumericStringMatch, ldapstrprep.NumericStringSubstringsMatch},
+	"telephone":  {ldapstrprep.TelephoneNumberMatch, ldapstrprep.TelephoneNumberSubstringsMatch},
+}
+
+//substringPositions maps the values of --substr to the positions of substrings.
+var substringPositions = map[string]ldapstrprep.SubstringPosition{
+	"initial": ldapstrprep.SubstringInitial,
+	"any":     ldapstrprep.SubstringAny,
+	"final":   ldapstrprep.SubstringFinal,
+}
+
+//prepareOptions are the flags of the prepare subcommand.
+type prepareOptions struct {
+	rule     cliRule
+	ruleName string
+	//caseFolding is passed to MapCharacters in --trace and --check-prohibited mode.
+	caseFolding     bool
+	substr          string
+	output          string
+	trace           bool
+	checkProhibited bool
+}
+
+//preparedValue is the result of preparing a value, which is written as JSON in --output json.
+type preparedValue struct {
+	Value      string      `json:"value"`
+	Rule       string      `json:"rule"`
+	Prepared   *string     `json:"prepared,omitempty"`
+	CodePoints []string    `json:"codePoints,omitempty"`
+	Steps      []traceStep `json:"steps,omitempty"`
+	Error      string      `json:"error,omitempty"`
+}
+
+//traceStep is the output of a step of the string preparation in --trace mode.
+type traceStep struct {
+	Step       string   `json:"step"`
+	Value      string   `json:"value"`
+	CodePoints []string `json:"codePoints"`
+}
+
+//runPrepare runs the prepare subcommand, which prepares the values given by args, or the lines of stdin.
+//The exit status is exitFound if a value cannot be prepared, or has prohibited characters in --check-prohibited mode.
+func runPrepare(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	fs := flag.NewFlagSet("prepare", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var opts prepareOptions
+	fs.StringVar(&opts.ruleName, "rule", "caseIgnore", "matching rule: caseIgnore, caseExact, numeric or telephone")
+	fs.StringVar(&opts.substr, "substr", "", "prepare the values as substrings at the position: initial, any or final")
+	fs.StringVar(&opts.output, "output", "text", "output format: text, hex or json")
+	fs.BoolVar(&opts.trace, "trace", false, "write the value after each step of the string preparation")
+	fs.BoolVar(&opts.checkProhibited, "check-prohibited", false, "only check whether the values have prohibited characters")
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	var ok bool
+	if opts.rule, ok = cliRules[opts.ruleName]; !ok {
+		fmt.Fprintf(stderr, "ldapstrprep: unknown rule %q\n", opts.ruleName)
+		return exitError
+	}
+	var err error
+	if opts.caseFolding, err = ldapstrprep.RuleCaseFolding(opts.rule.equality); err != nil {
+		fmt.Fprintf(stderr, "ldapstrprep: %v\n", err)
+		return exitError
+	}
+	if _, ok := substringPositions[opts.substr]; opts.substr != "" && !ok {
+		fmt.Fprintf(stderr, "ldapstrprep: unknown substring position %q\n", opts.substr)
+		return exitError
+	}
+	switch opts.output {
+	case "text", "hex", "json":
+	default:
+		fmt.Fprintf(stderr, "ldapstrprep: unknown output format %q\n", opts.output)
+		return exitError
+	}
+	values := fs.Args()
+	if len(values) == 0 {
+		if values, err = readLines(stdin); err != nil {
+			fmt.Fprintf(stderr, "ldapstrprep: %v\n", err)
+			return exitError
+		}
+	}
+	status := exitOK
+	for _, v := range values {
+		r := opts.prepare(v)
+		if r.Error != "" {
+			status = exitFound
+		}
+		if err := opts.write(stdout, stderr, r); err != nil {
+			fmt.Fprintf(stderr, "ldapstrprep: %v\n", err)
+			return exitError
+		}
+	}
+	return status
+}
+
+//prepare prepares v according to opts.
+func (opts *prepareOptions) prepare(v string) *preparedValue {
+	r := &preparedValue{Value: v, Rule: opts.rule.equality}
+	if opts.substr != "" {
+		r.Rule = opts.rule.substrings
+	}
+	if opts.trace {
+		r.Steps = opts.traceSteps(v)
+	}
+	if opts.checkProhibited {
+		src, err := ldapstrprep.TranscodeStrict(v)
+		if err == nil {
+			_, err = ldapstrprep.IsProhibited(ldapstrprep.Normalize(ldapstrprep.MapCharacters(src, opts.caseFolding)))
+		}
+		if err != nil {
+			r.Error = err.Error()
+		}
+		return r
+	}
+	var p []rune
+	var err error
+	if pos, ok := substringPositions[opts.substr]; ok {
+		p, err = ldapstrprep.PrepareSubstring(r.Rule, v, pos)
+	} else {
+		p, err = ldapstrprep.Prepare(r.Rule, v)
+	}
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+	s := string(p)
+	r.Prepared, r.CodePoints = &s, codePoints(p)
+	return r
+}
+
+//traceSteps returns the value after each step of the string preparation of v, until a step fails.
+func (opts *prepareOptions) traceSteps(v string) []traceStep {
+	steps := make([]traceStep, 0, 0)
+	add := func(step string, r []rune) {
+		steps = append(steps, traceStep{Step: step, Value: string(r), CodePoints: codePoints(r)})
+	}
+	r, err := ldapstrprep.TranscodeStrict(v)
+	if err != nil {
+		return steps
+	}
+	add("transcode", r)
+	r = ldapstrprep.MapCharacters(r, opts.caseFolding)
+	add("map", r)
+	r = ldapstrprep.Normalize(r)
+	add("normalize", r)
+	if _, err := ldapstrprep.IsProhibited(r); err != nil {
+		return steps
+	}
+	add("prohibit", r)
+	return steps
+}
+
+//write writes r in the output format of opts.
+func (opts *prepareOptions) write(stdout io.Writer, stderr io.Writer, r *preparedValue) error {
+	if opts.output == "json" {
+		b, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(stdout, "%s\n", b)
+		return err
+	}
+	format := func(s string, cps []string) string {
+		if opts.output == "hex" {
+			return strings.Join(cps, " ")
+		}
+		return s
+	}
+	for _, s := range r.Steps {
+		if _, err := fmt.Fprintf(stdout, "%s: %s\n", s.Step, format(s.Value, s.CodePoints)); err != nil {
+			return err
+		}
+	}
+	if r.Error != "" {
+		fmt.Fprintf(stderr, "%q: %s\n", r.Value, r.Error)
+		return nil
+	}
+	if opts.checkProhibited {
+		return nil
+	}
+	prefix := ""
+	if opts.trace {
+		prefix = "insignificant character handling: "
+	}
+	_, err := fmt.Fprintf(stdout, "%s%s\n", prefix, format(*r.Prepared, r.CodePoints))
+	return err
+}
+
+//codePoints returns the code points of r in the U+XXXX notation.
+func codePoints(r []rune) []string {
+	dst := make([]string, len(r))
+	for i, c := range r {
+		dst[i] = fmt.Sprintf("U+%04X", c)
+	}
+	return dst
+}
+
+//readLines reads the lines of r without line terminators.
+func readLines(r io.Reader) ([]string, error) {
+	lines := make([]string, 0, 0)
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		lines = append(lines, strings.TrimSuffix(sc.Text(), "\r"))
+	}
+	return lines, sc.Err()
+}
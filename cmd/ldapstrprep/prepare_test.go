@@ -0,0 +1,50 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRunPrepare(t *testing.T) {
+	type args struct {
+		args  []string
+		stdin string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantStatus int
+		wantStdout string
+	}{
+		{"TestCase:Default rule", args{[]string{"prepare", "  John   SMITH "}, ""}, exitOK, " john  smith \n"},
+		{"TestCase:Flags without subcommand", args{[]string{"--rule", "caseExact", "ＡＢＣ"}, ""}, exitOK, " ABC \n"},
+		{"TestCase:numeric", args{[]string{"--rule", "numeric", " 1 234 "}, ""}, exitOK, "1234\n"},
+		{"TestCase:telephone from stdin", args{[]string{"prepare", "--rule", "telephone"}, "+1 512-315-0280\r\n+81 3\n"}, exitOK, "+15123150280\n+813\n"},
+		{"TestCase:Substring", args{[]string{"--substr", "final", "  John  "}, ""}, exitOK, " john \n"},
+		{"TestCase:Hex", args{[]string{"--output", "hex", "Ａ"}, ""}, exitOK, "U+0020 U+0061 U+0020\n"},
+		{"TestCase:JSON", args{[]string{"--output", "json", "A", "a\U0000E000"}, ""}, exitFound, `{"value":"A","rule":"caseIgnoreMatch","prepared":" a ","codePoints":["U+0020","U+0061","U+0020"]}
+{"value":"a","rule":"caseIgnoreMatch","error":"ldapstrprep: U+E000 is prohibit character"}
+`},
+		{"TestCase:Trace", args{[]string{"--trace", "--rule", "caseExact", "Ａ"}, ""}, exitOK, "transcode: Ａ\nmap: Ａ\nnormalize: A\nprohibit: A\ninsignificant character handling:  A \n"},
+		{"TestCase:Trace of prohibited character", args{[]string{"--trace", "a\U0000E000"}, ""}, exitFound, "transcode: a\U0000E000\nmap: a\U0000E000\nnormalize: a\U0000E000\n"},
+		{"TestCase:Trace in JSON", args{[]string{"--trace", "--output", "json", "a"}, ""}, exitOK, `{"value":"a","rule":"caseIgnoreMatch","prepared":" a ","codePoints":["U+0020","U+0061","U+0020"],"steps":[{"step":"transcode","value":"a","codePoints":["U+0061"]},{"step":"map","value":"a","codePoints":["U+0061"]},{"step":"normalize","value":"a","codePoints":["U+0061"]},{"step":"prohibit","value":"a","codePoints":["U+0061"]}]}
+`},
+		{"TestCase:Check prohibited", args{[]string{"--check-prohibited", "a", "b"}, ""}, exitOK, ""},
+		{"TestCase:Check prohibited finds a prohibited character", args{[]string{"--check-prohibited", "a", "\uFFFD"}, ""}, exitFound, ""},
+		{"TestCase:Invalid UTF-8", args{[]string{"prepare", "\xFF"}, ""}, exitFound, ""},
+		{"TestCase:Unknown rule", args{[]string{"--rule", "integer", "1"}, ""}, exitError, ""},
+		{"TestCase:Unknown substring position", args{[]string{"--substr", "middle", "1"}, ""}, exitError, ""},
+		{"TestCase:Unknown output format", args{[]string{"--output", "xml", "1"}, ""}, exitError, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+			if got := run(tt.args.args, strings.NewReader(tt.args.stdin), &stdout, &stderr); got != tt.wantStatus {
+				t.Errorf("run() = %v, want %v, stderr = %s", got, tt.wantStatus, stderr.String())
+			}
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("run() stdout = %q, want %q", stdout.String(), tt.wantStdout)
+			}
+		})
+	}
+}
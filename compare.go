@@ -0,0 +1,100 @@
+package ldapstrprep
+
+//Compare prepares a and b for the matching rule named rule, and compares the prepared values by code point order.
+//The result is 0 if a == b, -1 if a < b, and +1 if a > b.
+//rule is usually an ordering matching rule, such as caseIgnoreOrderingMatch or caseExactOrderingMatch.
+//https://tools.ietf.org/html/rfc4517#section-4.2.12
+//https://tools.ietf.org/html/rfc4517#section-4.2.5
+func Compare(rule string, a string, b string) (int, error) {
+	pa, err := Prepare(rule, a)
+	if err != nil {
+		return 0, err
+	}
+	pb, err := Prepare(rule, b)
+	if err != nil {
+		return 0, err
+	}
+	return compareRunes(pa, pb), nil
+}
+
+//compareRunes compares a and b by code point order.
+func compareRunes(a []rune, b []rune) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] < b[i] {
+			return -1
+		}
+		if a[i] > b[i] {
+			return 1
+		}
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	default:
+		return 0
+	}
+}
+
+//EntrySorter implements sort.Interface to sort entries by the values of an attribute under an ordering matching rule,
+//as the server side sorting control does.
+//The key of an entry is its least value, or its greatest value in reverse order.
+//Entries which have no value are treated as if their values were greater than any other value, as RFC 2891 requires,
+//so they are sorted last, or first in reverse order.
+//https://tools.ietf.org/html/rfc2891#section-1.2
+type EntrySorter[E any] struct {
+	entries []E
+	keys    [][]rune
+	reverse bool
+}
+
+//NewEntrySorter returns an EntrySorter which sorts entries in place.
+//values returns the values of the sort attribute of an entry, and they are prepared for the matching rule named rule.
+//Values which cannot be prepared are treated as absent. An error is returned if rule is not supported.
+func NewEntrySorter[E any](rule string, entries []E, values func(entry E) []string, reverse bool) (*EntrySorter[E], error) {
+	if _, err := lookupRule(rule); err != nil {
+		return nil, err
+	}
+	s := &EntrySorter[E]{entries: entries, keys: make([][]rune, len(entries)), reverse: reverse}
+	for i, e := range entries {
+		for _, v := range values(e) {
+			p, err := Prepare(rule, v)
+			if err != nil {
+				continue
+			}
+			if s.keys[i] == nil || s.less(p, s.keys[i]) {
+				s.keys[i] = p
+			}
+		}
+	}
+	return s, nil
+}
+
+func (s *EntrySorter[E]) Len() int {
+	return len(s.entries)
+}
+
+func (s *EntrySorter[E]) Less(i, j int) bool {
+	if s.keys[i] == nil || s.keys[j] == nil {
+		//An absent value is greater than any value.
+		if s.reverse {
+			return s.keys[i] == nil && s.keys[j] != nil
+		}
+		return s.keys[i] != nil && s.keys[j] == nil
+	}
+	return s.less(s.keys[i], s.keys[j])
+}
+
+func (s *EntrySorter[E]) Swap(i, j int) {
+	s.entries[i], s.entries[j] = s.entries[j], s.entries[i]
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+}
+
+//less reports whether the prepared value a is sorted before b.
+func (s *EntrySorter[E]) less(a []rune, b []rune) bool {
+	if s.reverse {
+		return compareRunes(a, b) > 0
+	}
+	return compareRunes(a, b) < 0
+}
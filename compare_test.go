@@ -0,0 +1,92 @@
+package ldapstrprep
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	type args struct {
+		rule string
+		a    string
+		b    string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    int
+		wantErr bool
+	}{
+		{"TestCase:caseIgnoreOrderingMatch equal", args{CaseIgnoreOrderingMatch, "ＡＢＣ", " abc "}, 0, false},
+		{"TestCase:caseIgnoreOrderingMatch less", args{CaseIgnoreOrderingMatch, "abc", "ABD"}, -1, false},
+		{"TestCase:caseIgnoreOrderingMatch greater", args{CaseIgnoreOrderingMatch, "b", "ABC"}, 1, false},
+		{"TestCase:caseIgnoreOrderingMatch prefix", args{CaseIgnoreOrderingMatch, "ab", "abc"}, -1, false},
+		{"TestCase:caseExactOrderingMatch upper before lower", args{CaseExactOrderingMatch, "B", "a"}, -1, false},
+		{"TestCase:caseExactOrderingMatch code point order", args{CaseExactOrderingMatch, "\U00020000", "\U0000D7A3"}, 1, false},
+		{"TestCase:numericStringOrderingMatch", args{NumericStringOrderingMatch, "1 2 3", "124"}, -1, false},
+		{"TestCase:prohibited", args{CaseIgnoreOrderingMatch, "a", "\U0000E000"}, 0, true},
+		{"TestCase:unsupported rule", args{"integerOrderingMatch", "1", "2"}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Compare(tt.args.rule, tt.args.a, tt.args.b)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Compare() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntrySorter(t *testing.T) {
+	type entry struct {
+		dn string
+		sn []string
+	}
+	entries := []entry{
+		{"uid=1", []string{"smith"}},
+		{"uid=2", nil},
+		{"uid=3", []string{"ＢＡＫＥＲ"}},
+		{"uid=4", []string{"Young", "Adams"}},
+		{"uid=5", []string{"\U0000E000"}},
+		{"uid=6", []string{"  Jones "}},
+	}
+	type args struct {
+		rule    string
+		reverse bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []string
+		wantErr bool
+	}{
+		{"TestCase:ascending", args{CaseIgnoreOrderingMatch, false}, []string{"uid=4", "uid=3", "uid=6", "uid=1", "uid=2", "uid=5"}, false},
+		{"TestCase:descending", args{CaseIgnoreOrderingMatch, true}, []string{"uid=2", "uid=5", "uid=4", "uid=1", "uid=6", "uid=3"}, false},
+		{"TestCase:unsupported rule", args{"integerOrderingMatch", false}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sorted := append([]entry(nil), entries...)
+			s, err := NewEntrySorter(tt.args.rule, sorted, func(e entry) []string { return e.sn }, tt.args.reverse)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewEntrySorter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			sort.Stable(s)
+			got := make([]string, 0, 0)
+			for _, e := range sorted {
+				got = append(got, e.dn)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("sorted = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package ldapstrprep
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+//AttributeTypeAndValue is an attribute type and value of a relative distinguished name in the string representation.
+//Value is unescaped. If Hex is true, Value is in the hexstring form, such as "#04024869", which is kept as it is.
+//https://tools.ietf.org/html/rfc4514#section-3
+type AttributeTypeAndValue struct {
+	Type  string
+	Value string
+	Hex   bool
+}
+
+//RelativeDistinguishedName is a relative distinguished name, which has one or more attribute types and values.
+type RelativeDistinguishedName []AttributeTypeAndValue
+
+//DistinguishedName is a distinguished name in the string representation.
+//The relative distinguished names are in the order of the string, from the leaf to the root.
+type DistinguishedName []RelativeDistinguishedName
+
+//ParseDN parses s as the string representation of a distinguished name, such as "cn=Foo+uid=foo,dc=example,dc=com".
+//Spaces around the separators are ignored. The empty string is the empty distinguished name.
+//https://tools.ietf.org/html/rfc4514#section-3
+func ParseDN(s string) (DistinguishedName, error) {
+	dn := make(DistinguishedName, 0, 0)
+	if strings.TrimSpace(s) == "" {
+		return dn, nil
+	}
+	rdn := make(RelativeDistinguishedName, 0, 1)
+	for i := 0; ; {
+		eq := strings.IndexByte(s[i:], '=')
+		if eq < 0 {
+			return nil, fmt.Errorf("ldapstrprep: invalid DN %q: attribute type without value at offset %d", s, i)
+		}
+		typ := strings.TrimSpace(s[i : i+eq])
+		if !isOID(typ) {
+			return nil, fmt.Errorf("ldapstrprep: invalid DN %q: invalid attribute type %q", s, typ)
+		}
+		value, isHex, n, err := parseDNValue(s[i+eq+1:])
+		if err != nil {
+			return nil, fmt.Errorf("ldapstrprep: invalid DN %q: %v", s, err)
+		}
+		rdn = append(rdn, AttributeTypeAndValue{Type: typ, Value: value, Hex: isHex})
+		i += eq + 1 + n
+		if i == len(s) {
+			return append(dn, rdn), nil
+		}
+		if s[i] == ',' {
+			dn = append(dn, rdn)
+			rdn = make(RelativeDistinguishedName, 0, 1)
+		}
+		i++
+	}
+}
+
+//parseDNValue parses the attribute value at the beginning of s, and returns the unescaped value,
+//whether it is a hexstring and the length of it in s. The value ends at an unescaped comma or plus sign, or at the end of s.
+func parseDNValue(s string) (value string, isHex bool, n int, err error) {
+	i := len(s) - len(strings.TrimLeft(s, " "))
+	if i < len(s) && s[i] == '#' {
+		end := strings.IndexAny(s[i:], ",+")
+		if end < 0 {
+			end = len(s) - i
+		}
+		v := strings.TrimRight(s[i:i+end], " ")
+		if _, err := hex.DecodeString(v[1:]); err != nil || len(v) == 1 {
+			return "", false, 0, fmt.Errorf("invalid hexstring %q", v)
+		}
+		return v, true, i + end, nil
+	}
+	var b strings.Builder
+	//trailing is the length of b without the unescaped trailing spaces.
+	trailing := 0
+	for ; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == ',' || c == '+':
+			return b.String()[:trailing], false, i, nil
+		case c == '\\':
+			if i+2 < len(s) && isHexDigit(s[i+1]) && isHexDigit(s[i+2]) {
+				b.WriteByte(unhex(s[i+1])<<4 | unhex(s[i+2]))
+				i += 2
+			} else if i+1 < len(s) && strings.IndexByte(` "#+,;<=>\`, s[i+1]) >= 0 {
+				b.WriteByte(s[i+1])
+				i++
+			} else {
+				return "", false, 0, fmt.Errorf("invalid escape sequence at offset %d", i)
+			}
+			trailing = b.Len()
+		default:
+			b.WriteByte(c)
+			if c != ' ' {
+				trailing = b.Len()
+			}
+		}
+	}
+	return b.String()[:trailing], false, i, nil
+}
+
+//String returns the string representation of dn, escaping the values as RFC 4514 requires.
+//https://tools.ietf.org/html/rfc4514#section-2
+func (dn DistinguishedName) String() string {
+	var b strings.Builder
+	for i, rdn := range dn {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		for j, atv := range rdn {
+			if j != 0 {
+				b.WriteByte('+')
+			}
+			b.WriteString(atv.Type)
+			b.WriteByte('=')
+			if atv.Hex {
+				b.WriteString(atv.Value)
+				continue
+			}
+			writeDNValue(&b, atv.Value)
+		}
+	}
+	return b.String()
+}
+
+//writeDNValue writes the escaped attribute value v to b.
+func writeDNValue(b *strings.Builder, v string) {
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		switch {
+		case c == 0X00:
+			b.WriteString(`\00`)
+			continue
+		case strings.IndexByte(`"+,;<>\`, c) >= 0,
+			i == 0 && (c == ' ' || c == '#'),
+			i == len(v)-1 && c == ' ':
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+}
+
+//CanonicalDN returns the canonical form of dn. If the canonical forms of two DNs are equal,
+//the DNs are equal under the equality matching rules of the attribute types.
+//Attribute types are replaced by the lower-cased first names in s, or lower-cased as they are if s does not know them.
+//Values are prepared for the equality matching rules, and the words of the prepared values are joined with a space.
+//The attribute types and values of each relative distinguished name are sorted.
+//The converse does not always hold, because some values are compared as text. Hexstrings (#-encoded BER values)
+//are only lower-cased, so "cn=#0c03616263" and "cn=abc" have different canonical forms. Values of the attribute types
+//which s does not know, or whose equality matching rules are not supported by this package, are kept as they are.
+func (s *Schema) CanonicalDN(dn string) (string, error) {
+	parsed, err := ParseDN(dn)
+	if err != nil {
+		return "", err
+	}
+	for _, rdn := range parsed {
+		for i, atv := range rdn {
+			if rdn[i].Value, err = s.canonicalDNValue(atv); err != nil {
+				return "", fmt.Errorf("ldapstrprep: DN %q: attribute %s: %w", dn, atv.Type, err)
+			}
+			rdn[i].Type = s.canonicalAttributeType(atv.Type)
+		}
+		sort.Slice(rdn, func(i, j int) bool {
+			if rdn[i].Type != rdn[j].Type {
+				return rdn[i].Type < rdn[j].Type
+			}
+			return rdn[i].Value < rdn[j].Value
+		})
+	}
+	return parsed.String(), nil
+}
+
+//canonicalDNValue returns the canonical form of the value of atv. See CanonicalDN.
+func (s *Schema) canonicalDNValue(atv AttributeTypeAndValue) (string, error) {
+	if atv.Hex {
+		return strings.ToLower(atv.Value), nil
+	}
+	rule := s.EqualityRule(atv.Type)
+	if _, err := lookupRule(rule); err != nil {
+		return atv.Value, nil
+	}
+	p, err := Prepare(rule, atv.Value)
+	if err != nil {
+		return "", err
+	}
+	return joinWords(p), nil
+}
+
+//joinWords returns the words of src joined with single spaces, which drops the insignificant spaces of src.
+func joinWords(src []rune) string {
+	words := splitToWords(src)
+	strs := make([]string, len(words))
+	for i := range words {
+		strs[i] = string(words[i])
+	}
+	return strings.Join(strs, " ")
+}
+
+//canonicalAttributeType returns the lower-cased first name of the attribute type attr in s,
+//or the lower-cased attr if s does not know it.
+func (s *Schema) canonicalAttributeType(attr string) string {
+	if a, ok := s.AttributeType(attr); ok {
+		return strings.ToLower(a.Name())
+	}
+	return strings.ToLower(attr)
+}
+
+//isHexDigit reports whether c is a hexadecimal digit.
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
@@ -0,0 +1,112 @@
+package ldapstrprep
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDN(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    DistinguishedName
+		wantErr bool
+	}{
+		{"TestCase:Empty", args{""}, DistinguishedName{}, false},
+		{"TestCase:Simple", args{"cn=Foo,dc=example,dc=com"}, DistinguishedName{{{Type: "cn", Value: "Foo"}}, {{Type: "dc", Value: "example"}}, {{Type: "dc", Value: "com"}}}, false},
+		{"TestCase:Multi-valued RDN and spaces around separators", args{" cn = Foo Bar + uid=foo , 2.5.4.10=Example"}, DistinguishedName{{{Type: "cn", Value: "Foo Bar"}, {Type: "uid", Value: "foo"}}, {{Type: "2.5.4.10", Value: "Example"}}}, false},
+		{"TestCase:Escaped characters", args{`cn=\#Foo\, Bar\+\\\20,o=\E6\97\A5\E6\9C\AC`}, DistinguishedName{{{Type: "cn", Value: `#Foo, Bar+\ `}}, {{Type: "o", Value: "日本"}}}, false},
+		{"TestCase:Hexstring", args{"cn=#04024869 ,o=Example"}, DistinguishedName{{{Type: "cn", Value: "#04024869", Hex: true}}, {{Type: "o", Value: "Example"}}}, false},
+		{"TestCase:Empty value", args{"cn=,o=Example"}, DistinguishedName{{{Type: "cn", Value: ""}}, {{Type: "o", Value: "Example"}}}, false},
+		{"TestCase:No value", args{"cn=Foo,o"}, nil, true},
+		{"TestCase:Trailing comma", args{"cn=Foo,"}, nil, true},
+		{"TestCase:Invalid attribute type", args{"c n=Foo"}, nil, true},
+		{"TestCase:Invalid escape sequence", args{`cn=Foo\`}, nil, true},
+		{"TestCase:Invalid hexstring", args{"cn=#0G"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDN(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDN() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseDN() got = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistinguishedName_String(t *testing.T) {
+	tests := []struct {
+		name string
+		dn   DistinguishedName
+		want string
+	}{
+		{"TestCase:Empty", DistinguishedName{}, ""},
+		{"TestCase:Escaped characters", DistinguishedName{{{Type: "cn", Value: ` #Foo, "Bar"+<Baz>;\ `}, {Type: "uid", Value: "a\x00b"}}, {{Type: "cn", Value: "#04024869", Hex: true}}}, `cn=\ #Foo\, \"Bar\"\+\<Baz\>\;\\\ +uid=a\00b,cn=#04024869`},
+		{"TestCase:Leading number sign", DistinguishedName{{{Type: "cn", Value: "#Foo"}}}, `cn=\#Foo`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dn.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			got, err := ParseDN(tt.dn.String())
+			if err != nil || !reflect.DeepEqual(got, tt.dn) {
+				t.Errorf("ParseDN(String()) = %#v, %v, want %#v", got, err, tt.dn)
+			}
+		})
+	}
+}
+
+func TestSchema_CanonicalDN(t *testing.T) {
+	s := NewSchema()
+	for _, def := range []string{
+		"( 2.5.4.41 NAME 'name' EQUALITY caseIgnoreMatch SUBSTR caseIgnoreSubstringsMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.15{32768} )",
+		"( 2.5.4.3 NAME ( 'cn' 'commonName' ) SUP name )",
+		"( 0.9.2342.19200300.100.1.25 NAME ( 'dc' 'domainComponent' ) EQUALITY caseIgnoreIA5Match SYNTAX 1.3.6.1.4.1.1466.115.121.1.26 SINGLE-VALUE )",
+		"( 2.5.4.5 NAME 'serialNumber' EQUALITY caseIgnoreMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.44 )",
+		"( 1.2.3.4 NAME 'employeeNumberX' EQUALITY numericStringMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.36 )",
+	} {
+		if err := s.AddAttributeType(def); err != nil {
+			t.Fatal(err)
+		}
+	}
+	type args struct {
+		dn string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{"TestCase:caseIgnoreMatch", args{"CN=  John   SMITH ,dc=Example"}, "cn=john smith,dc=example", false},
+		{"TestCase:caseIgnoreIA5Match non-IA5", args{"dc=Ex\u00E4mple"}, "", true},
+		{"TestCase:Fullwidth", args{"commonName=ＡＢＣ"}, "cn=abc", false},
+		{"TestCase:numericStringMatch and OID", args{"1.2.3.4=1 2 3"}, "employeenumberx=123", false},
+		{"TestCase:Multi-valued RDN is sorted", args{"serialNumber=B+CN=a"}, "cn=a+serialnumber=b", false},
+		{"TestCase:Unknown attribute type", args{"UID=Foo"}, "uid=Foo", false},
+		{"TestCase:Hexstring", args{"cn=#0402AB"}, "cn=#0402ab", false},
+		{"TestCase:Escaped characters are kept escaped", args{`cn=a\,b`}, `cn=a\,b`, false},
+		{"TestCase:Prohibited character", args{"cn=a�b"}, "", true},
+		{"TestCase:Invalid DN", args{"cn"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.CanonicalDN(tt.args.dn)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CanonicalDN() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("CanonicalDN() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package ldapstrprep
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/tardevnull/ldapstrprep/ldif"
+)
+
+//Occurrence is a value of an attribute of an entry. Line is the line number where the entry starts.
+type Occurrence struct {
+	DN    string
+	Line  int
+	Value string
+}
+
+//DuplicateGroup is a group of values which are equal under the equality matching rule Rule of the attribute Attribute.
+//Attribute is the attribute description of the first occurrence. The values of aliases such as cn, commonName
+//and 2.5.4.3 are in one group.
+//Key is the prepared value, or the canonical DN for the groups of DNs.
+type DuplicateGroup struct {
+	Attribute   string
+	Rule        string
+	Key         string
+	Occurrences []Occurrence
+}
+
+//DuplicateReport is the result of FindDuplicates.
+type DuplicateReport struct {
+	//DNs are the groups of entries whose DNs are equal under CanonicalDN.
+	//The Value and the DN of their Occurrences are the DNs.
+	DNs []DuplicateGroup
+	//Values are the groups of values of an attribute of an entry which are equal.
+	//A server rejects such an entry, because an attribute must not have equal values.
+	Values []DuplicateGroup
+	//Collisions are the groups of values of an attribute in different entries, which are equal
+	//but have different representations, such as "ＡＢＣ" and "abc".
+	Collisions []DuplicateGroup
+}
+
+//HasDuplicates reports whether r has any group.
+func (r *DuplicateReport) HasDuplicates() bool {
+	return len(r.DNs) != 0 || len(r.Values) != 0 || len(r.Collisions) != 0
+}
+
+//FindDuplicates finds the values and DNs of recs which collide once prepared for the equality matching rules
+//of their attributes in s. Content records and add change records are scanned, and the other records are ignored.
+//Attributes whose equality matching rules are not supported by this package, values and DNs which cannot be
+//prepared are ignored. Attribute descriptions are compared by attributeDescriptionKey, so the aliases and the OID
+//of an attribute type are the same attribute. Groups are in the order of their first occurrences.
+//https://tools.ietf.org/html/rfc4512#section-2.3
+func (s *Schema) FindDuplicates(recs []*ldif.Record) *DuplicateReport {
+	dns := newDuplicateGroups()
+	collisions := newDuplicateGroups()
+	r := &DuplicateReport{Values: make([]DuplicateGroup, 0, 0)}
+	for _, rec := range recs {
+		if rec.IsChange() && rec.ChangeType != ldif.ChangeTypeAdd {
+			continue
+		}
+		if key, err := s.CanonicalDN(rec.DN); err == nil {
+			dns.add("dn", "dn", "", key, Occurrence{DN: rec.DN, Line: rec.Line, Value: rec.DN})
+		}
+		values := newDuplicateGroups()
+		for _, a := range rec.Attributes {
+			rule := s.EqualityRule(a.Type)
+			if _, err := lookupRule(rule); err != nil {
+				continue
+			}
+			attr := s.attributeDescriptionKey(a.Type)
+			for _, v := range a.Values {
+				p, err := Prepare(rule, v)
+				if err != nil {
+					continue
+				}
+				o := Occurrence{DN: rec.DN, Line: rec.Line, Value: v}
+				values.add(attr, a.Type, rule, string(p), o)
+				collisions.add(attr, a.Type, rule, string(p), o)
+			}
+		}
+		r.Values = append(r.Values, values.duplicates(false)...)
+	}
+	r.DNs = dns.duplicates(false)
+	r.Collisions = collisions.duplicates(true)
+	return r
+}
+
+//attributeDescriptionKey returns the canonical attribute type of the attribute description attr in s,
+//followed by the lower-cased options of attr in sorted order, because options are case-insensitive and unordered.
+//https://tools.ietf.org/html/rfc4512#section-2.5
+func (s *Schema) attributeDescriptionKey(attr string) string {
+	fields := strings.Split(strings.TrimSpace(attr), ";")
+	options := fields[1:]
+	for i := range options {
+		options[i] = strings.ToLower(options[i])
+	}
+	sort.Strings(options)
+	return strings.Join(append([]string{s.canonicalAttributeType(fields[0])}, options...), ";")
+}
+
+//duplicateGroups collects DuplicateGroups keyed by attribute descriptions and prepared values.
+type duplicateGroups struct {
+	groups []DuplicateGroup
+	index  map[string]int
+}
+
+func newDuplicateGroups() *duplicateGroups {
+	return &duplicateGroups{groups: make([]DuplicateGroup, 0, 0), index: make(map[string]int)}
+}
+
+//add adds o to the group of the canonical attribute description attrKey and the prepared value key.
+//attr is the attribute description of o.
+func (g *duplicateGroups) add(attrKey string, attr string, rule string, key string, o Occurrence) {
+	k := attrKey + "\x00" + key
+	i, ok := g.index[k]
+	if !ok {
+		i = len(g.groups)
+		g.index[k] = i
+		g.groups = append(g.groups, DuplicateGroup{Attribute: attr, Rule: rule, Key: key, Occurrences: make([]Occurrence, 0, 0)})
+	}
+	g.groups[i].Occurrences = append(g.groups[i].Occurrences, o)
+}
+
+//duplicates returns the groups which have two or more occurrences.
+//If acrossEntries is true, only the groups whose occurrences have different values in different entries are returned.
+func (g *duplicateGroups) duplicates(acrossEntries bool) []DuplicateGroup {
+	dst := make([]DuplicateGroup, 0, 0)
+	for _, group := range g.groups {
+		if len(group.Occurrences) < 2 {
+			continue
+		}
+		if acrossEntries {
+			values := hasDistinct(group.Occurrences, func(o Occurrence) string { return o.Value })
+			entries := hasDistinct(group.Occurrences, func(o Occurrence) string { return o.DN })
+			if !values || !entries {
+				continue
+			}
+		}
+		dst = append(dst, group)
+	}
+	return dst
+}
+
+//hasDistinct reports whether the fields of occurrences returned by field have two or more different values.
+func hasDistinct(occurrences []Occurrence, field func(o Occurrence) string) bool {
+	for _, o := range occurrences[1:] {
+		if field(o) != field(occurrences[0]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package ldapstrprep
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/tardevnull/ldapstrprep/ldif"
+)
+
+//testSchema returns a Schema which has cn, sn, mail and telephoneNumber.
+func testSchema(t *testing.T) *Schema {
+	t.Helper()
+	s := NewSchema()
+	for _, def := range []string{
+		"( 2.5.4.41 NAME 'name' EQUALITY caseIgnoreMatch SUBSTR caseIgnoreSubstringsMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.15{32768} )",
+		"( 2.5.4.3 NAME ( 'cn' 'commonName' ) SUP name )",
+		"( 2.5.4.4 NAME ( 'sn' 'surname' ) SUP name )",
+		"( 2.5.4.20 NAME 'telephoneNumber' EQUALITY telephoneNumberMatch SUBSTR telephoneNumberSubstringsMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.50{32} )",
+		"( 2.5.4.0 NAME 'objectClass' EQUALITY objectIdentifierMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.38 )",
+	} {
+		if err := s.AddAttributeType(def); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return s
+}
+
+func TestSchema_FindDuplicates(t *testing.T) {
+	s := testSchema(t)
+	type args struct {
+		ldif string
+	}
+	tests := []struct {
+		name string
+		args args
+		want *DuplicateReport
+	}{
+		{"TestCase:No duplicates", args{"dn: cn=Foo\nobjectClass: person\ncn: Foo\nsn: Smith\n\ndn: cn=Bar\nobjectClass: person\ncn: Bar\nsn: Smith\n"}, &DuplicateReport{
+			DNs: []DuplicateGroup{}, Values: []DuplicateGroup{}, Collisions: []DuplicateGroup{},
+		}},
+		{"TestCase:Duplicate DNs", args{"dn: cn=John  Smith,o=Example\ncn: John  Smith\n\ndn: CN=john smith,o=Example\ncn: john smith\n\ndn: cn=Foo,o=Example\ncn: Foo\n"}, &DuplicateReport{
+			DNs: []DuplicateGroup{{Attribute: "dn", Rule: "", Key: "cn=john smith,o=Example", Occurrences: []Occurrence{
+				{DN: "cn=John  Smith,o=Example", Line: 1, Value: "cn=John  Smith,o=Example"},
+				{DN: "CN=john smith,o=Example", Line: 4, Value: "CN=john smith,o=Example"},
+			}}},
+			Values: []DuplicateGroup{},
+			Collisions: []DuplicateGroup{{Attribute: "cn", Rule: "caseIgnoreMatch", Key: " john  smith ", Occurrences: []Occurrence{
+				{DN: "cn=John  Smith,o=Example", Line: 1, Value: "John  Smith"},
+				{DN: "CN=john smith,o=Example", Line: 4, Value: "john smith"},
+			}}},
+		}},
+		{"TestCase:Duplicate values of an attribute", args{"dn: cn=Foo\ncn: Foo\ncn: ＦＯＯ\nobjectClass: top\nobjectClass: top\ntelephoneNumber: +1 555 0100\ntelephoneNumber: +1-555-0100\n"}, &DuplicateReport{
+			DNs: []DuplicateGroup{},
+			Values: []DuplicateGroup{
+				{Attribute: "cn", Rule: "caseIgnoreMatch", Key: " foo ", Occurrences: []Occurrence{{DN: "cn=Foo", Line: 1, Value: "Foo"}, {DN: "cn=Foo", Line: 1, Value: "ＦＯＯ"}}},
+				{Attribute: "telephoneNumber", Rule: "telephoneNumberMatch", Key: "+15550100", Occurrences: []Occurrence{{DN: "cn=Foo", Line: 1, Value: "+1 555 0100"}, {DN: "cn=Foo", Line: 1, Value: "+1-555-0100"}}},
+			},
+			Collisions: []DuplicateGroup{},
+		}},
+		{"TestCase:Collisions across entries", args{"dn: cn=A\nsn: ＡＢＣ\n\ndn: cn=B\nSN: abc\n\ndn: cn=C\nsn: Same\n\ndn: cn=D\nsn: Same\n\ndn: cn=E\nchangetype: modify\nreplace: sn\nsn: ABC\n-\n"}, &DuplicateReport{
+			DNs:    []DuplicateGroup{},
+			Values: []DuplicateGroup{},
+			Collisions: []DuplicateGroup{{Attribute: "sn", Rule: "caseIgnoreMatch", Key: " abc ", Occurrences: []Occurrence{
+				{DN: "cn=A", Line: 1, Value: "ＡＢＣ"},
+				{DN: "cn=B", Line: 4, Value: "abc"},
+			}}},
+		}},
+		{"TestCase:Duplicate values of aliases and the OID", args{"dn: cn=Foo\ncn: Foo\ncommonName: FOO\n2.5.4.3: foo\nsn: Foo\n"}, &DuplicateReport{
+			DNs: []DuplicateGroup{},
+			Values: []DuplicateGroup{{Attribute: "cn", Rule: "caseIgnoreMatch", Key: " foo ", Occurrences: []Occurrence{
+				{DN: "cn=Foo", Line: 1, Value: "Foo"},
+				{DN: "cn=Foo", Line: 1, Value: "FOO"},
+				{DN: "cn=Foo", Line: 1, Value: "foo"},
+			}}},
+			Collisions: []DuplicateGroup{},
+		}},
+		{"TestCase:Duplicate values of attribute descriptions with options", args{"dn: cn=Foo\ncn;lang-en: Foo\n2.5.4.3;LANG-EN: FOO\ncn: foo\ncn;lang-ja: foo\n"}, &DuplicateReport{
+			DNs: []DuplicateGroup{},
+			Values: []DuplicateGroup{{Attribute: "cn;lang-en", Rule: "caseIgnoreMatch", Key: " foo ", Occurrences: []Occurrence{
+				{DN: "cn=Foo", Line: 1, Value: "Foo"},
+				{DN: "cn=Foo", Line: 1, Value: "FOO"},
+			}}},
+			Collisions: []DuplicateGroup{},
+		}},
+		{"TestCase:Collisions across entries of aliases and the OID", args{"dn: cn=A\ncommonName: ＡＢＣ\n\ndn: cn=B\n2.5.4.3: abc\n\ndn: cn=C\ncn: Abc\n"}, &DuplicateReport{
+			DNs:    []DuplicateGroup{},
+			Values: []DuplicateGroup{},
+			Collisions: []DuplicateGroup{{Attribute: "commonName", Rule: "caseIgnoreMatch", Key: " abc ", Occurrences: []Occurrence{
+				{DN: "cn=A", Line: 1, Value: "ＡＢＣ"},
+				{DN: "cn=B", Line: 4, Value: "abc"},
+				{DN: "cn=C", Line: 7, Value: "Abc"},
+			}}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recs, err := ldif.NewReader(strings.NewReader(tt.args.ldif)).ReadAll()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := s.FindDuplicates(recs)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindDuplicates() got = %+v, want %+v", got, tt.want)
+			}
+			if got.HasDuplicates() != (len(tt.want.DNs)+len(tt.want.Values)+len(tt.want.Collisions) != 0) {
+				t.Errorf("HasDuplicates() = %v", got.HasDuplicates())
+			}
+		})
+	}
+}
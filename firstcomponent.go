@@ -0,0 +1,112 @@
+package ldapstrprep
+
+import (
+	"fmt"
+	"strings"
+)
+
+//PrepareFirstComponent prepares the first component of s, which is a RFC 4512 description such as a value of attributeTypes,
+//for the matching rule named rule, which is directoryStringFirstComponentMatch or objectIdentifierFirstComponentMatch.
+//The first component is the numericoid, or the word or quoted string which follows the opening parenthesis.
+//It is prepared as a value of caseIgnoreMatch for directoryStringFirstComponentMatch,
+//and must be a numericoid, which is returned as it is, for objectIdentifierFirstComponentMatch.
+//So two descriptions define the same schema element if their prepared first components are equal.
+//https://tools.ietf.org/html/rfc4517#section-4.2.14
+//https://tools.ietf.org/html/rfc4517#section-4.2.26
+func PrepareFirstComponent(rule string, s string) ([]rune, error) {
+	first, _, err := parseFirstComponent(s)
+	if err != nil {
+		return nil, err
+	}
+	switch strings.ToLower(matchingRuleName(rule)) {
+	case strings.ToLower(DirectoryStringFirstComponentMatch):
+		return Prepare(CaseIgnoreMatch, first)
+	case strings.ToLower(ObjectIdentifierFirstComponentMatch):
+		if !isNumericOID(first) {
+			return nil, fmt.Errorf("ldapstrprep: first component %q of description is not a numericoid", first)
+		}
+		return []rune(first), nil
+	default:
+		return nil, fmt.Errorf("ldapstrprep: unsupported first component matching rule %q", rule)
+	}
+}
+
+//MatchDirectoryStringFirstComponent reports whether attributeValue matches assertionValue by directoryStringFirstComponentMatch.
+//attributeValue is a RFC 4512 description, and its first component matches assertionValue by caseIgnoreMatch.
+//https://tools.ietf.org/html/rfc4517#section-4.2.14
+func MatchDirectoryStringFirstComponent(attributeValue string, assertionValue string) (bool, error) {
+	attr, err := PrepareFirstComponent(DirectoryStringFirstComponentMatch, attributeValue)
+	if err != nil {
+		return false, err
+	}
+	assertion, err := Prepare(CaseIgnoreMatch, assertionValue)
+	if err != nil {
+		return false, err
+	}
+	return compareRunes(attr, assertion) == 0, nil
+}
+
+//MatchObjectIdentifierFirstComponent reports whether attributeValue matches assertionValue by objectIdentifierFirstComponentMatch.
+//attributeValue is a RFC 4512 description whose first component is a numericoid, and assertionValue is an oid.
+//A numericoid matches the first component if they are equal, and a descr matches if it is one of the NAMEs of attributeValue,
+//ignoring case, so "( 2.5.4.3 NAME ( 'cn' 'commonName' ) SUP name )" matches "2.5.4.3", "cn" and "CommonName".
+//https://tools.ietf.org/html/rfc4517#section-4.2.26
+func MatchObjectIdentifierFirstComponent(attributeValue string, assertionValue string) (bool, error) {
+	first, names, err := parseFirstComponent(attributeValue)
+	if err != nil {
+		return false, err
+	}
+	if !isNumericOID(first) {
+		return false, fmt.Errorf("ldapstrprep: first component %q of description is not a numericoid", first)
+	}
+	oid := strings.TrimSpace(assertionValue)
+	switch {
+	case isNumericOID(oid):
+		return oid == first, nil
+	case isDescr(oid):
+		for _, name := range names {
+			if strings.EqualFold(name, oid) {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("ldapstrprep: invalid oid %q", assertionValue)
+	}
+}
+
+//parseFirstComponent parses s as a RFC 4512 description, and returns its first component and the values of its NAME field.
+//The other fields are not interpreted, so s can be any kind of description, such as a value of ldapSyntaxes.
+//https://tools.ietf.org/html/rfc4512#section-4.1
+func parseFirstComponent(s string) (first string, names []string, err error) {
+	tokens, err := tokenizeDescription(s)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(tokens) < 3 || !tokens[0].isSymbol("(") || !tokens[len(tokens)-1].isSymbol(")") {
+		return "", nil, fmt.Errorf("ldapstrprep: description %q is not enclosed in parentheses", s)
+	}
+	tokens = tokens[1 : len(tokens)-1]
+	if tokens[0].isSymbol("(") || tokens[0].isSymbol(")") {
+		return "", nil, fmt.Errorf("ldapstrprep: description %q has no first component", s)
+	}
+	depth := 0
+	for i, t := range tokens {
+		switch {
+		case t.isSymbol("("):
+			depth++
+		case t.isSymbol(")"):
+			if depth--; depth < 0 {
+				return "", nil, fmt.Errorf("ldapstrprep: unbalanced parentheses in description %q", s)
+			}
+		case depth == 0 && i != 0 && t.isSymbol("NAME") && names == nil:
+			if names, _, err = parseQDStrings(tokens[i+1:]); err != nil {
+				return "", nil, fmt.Errorf("ldapstrprep: NAME of %s: %v", tokens[0].text, err)
+			}
+		}
+	}
+	if depth != 0 {
+		return "", nil, fmt.Errorf("ldapstrprep: unbalanced parentheses in description %q", s)
+	}
+	return tokens[0].text, names, nil
+}
@@ -0,0 +1,112 @@
+package ldapstrprep
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPrepareFirstComponent(t *testing.T) {
+	type args struct {
+		rule string
+		s    string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []rune
+		wantErr bool
+	}{
+		{"TestCase:attribute type", args{ObjectIdentifierFirstComponentMatch, "( 2.5.4.3 NAME ( 'cn' 'commonName' ) SUP name )"}, []rune("2.5.4.3"), false},
+		{"TestCase:ldap syntax", args{"2.5.13.30", "( 1.3.6.1.4.1.1466.115.121.1.15 DESC 'Directory String' )"}, []rune("1.3.6.1.4.1.1466.115.121.1.15"), false},
+		{"TestCase:directory string", args{DirectoryStringFirstComponentMatch, "( 2.5.4.3 NAME 'cn' SUP name )"}, []rune(" 2.5.4.3 "), false},
+		{"TestCase:quoted directory string", args{DirectoryStringFirstComponentMatch, "( 'Common  Name' DESC 'x' )"}, []rune(" common  name "), false},
+		{"TestCase:escaped directory string", args{DirectoryStringFirstComponentMatch, `( 'O\27Brien' )`}, []rune(" o'brien "), false},
+		{"TestCase:not a numericoid", args{ObjectIdentifierFirstComponentMatch, "( 1 NAME 'rule' FORM form )"}, nil, true},
+		{"TestCase:no first component", args{DirectoryStringFirstComponentMatch, "( ( 'a' ) )"}, nil, true},
+		{"TestCase:no parentheses", args{ObjectIdentifierFirstComponentMatch, "2.5.4.3 NAME 'cn'"}, nil, true},
+		{"TestCase:unbalanced parentheses", args{ObjectIdentifierFirstComponentMatch, "( 2.5.4.3 NAME ( 'cn' SUP name )"}, nil, true},
+		{"TestCase:unsupported rule", args{CaseIgnoreMatch, "( 2.5.4.3 NAME 'cn' SUP name )"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PrepareFirstComponent(tt.args.rule, tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PrepareFirstComponent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PrepareFirstComponent() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchDirectoryStringFirstComponent(t *testing.T) {
+	type args struct {
+		attributeValue string
+		assertionValue string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"TestCase:numericoid", args{"( 2.5.4.3 NAME 'cn' SUP name )", "2.5.4.3"}, true, false},
+		{"TestCase:case and spaces", args{"( 'Common  Name' DESC 'x' )", " COMMON NAME "}, true, false},
+		{"TestCase:different", args{"( 2.5.4.3 NAME 'cn' SUP name )", "2.5.4.4"}, false, false},
+		{"TestCase:name is not the first component", args{"( 2.5.4.3 NAME 'cn' SUP name )", "cn"}, false, false},
+		{"TestCase:invalid description", args{"( 2.5.4.3 NAME 'cn", "2.5.4.3"}, false, true},
+		{"TestCase:prohibited assertion value", args{"( 2.5.4.3 )", "\U0000E000"}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchDirectoryStringFirstComponent(tt.args.attributeValue, tt.args.assertionValue)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MatchDirectoryStringFirstComponent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MatchDirectoryStringFirstComponent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchObjectIdentifierFirstComponent(t *testing.T) {
+	type args struct {
+		attributeValue string
+		assertionValue string
+	}
+	const cn = "( 2.5.4.3 NAME ( 'cn' 'commonName' ) SUP name )"
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"TestCase:numericoid", args{cn, "2.5.4.3"}, true, false},
+		{"TestCase:different numericoid", args{cn, "2.5.4.30"}, false, false},
+		{"TestCase:name", args{cn, "cn"}, true, false},
+		{"TestCase:name ignoring case", args{cn, "CommonName"}, true, false},
+		{"TestCase:different name", args{cn, "sn"}, false, false},
+		{"TestCase:single name", args{"( 2.5.6.6 NAME 'person' SUP top STRUCTURAL MUST ( sn $ cn ) )", "person"}, true, false},
+		{"TestCase:name in other field", args{"( 2.5.6.6 NAME 'person' SUP top STRUCTURAL MUST ( sn $ cn ) )", "top"}, false, false},
+		{"TestCase:no name", args{"( 1.3.6.1.4.1.1466.115.121.1.15 DESC 'Directory String' )", "directoryString"}, false, false},
+		{"TestCase:invalid oid", args{cn, "2.5.4.03"}, false, true},
+		{"TestCase:first component is not a numericoid", args{"( cn-oid NAME 'cn' )", "cn"}, false, true},
+		{"TestCase:invalid NAME", args{"( 2.5.4.3 NAME cn )", "cn"}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchObjectIdentifierFirstComponent(tt.args.attributeValue, tt.args.assertionValue)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MatchObjectIdentifierFirstComponent() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MatchObjectIdentifierFirstComponent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package ldapstrprep
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/tardevnull/ldapstrprep/stringprep"
+	"golang.org/x/text/unicode/norm"
+)
+
+//fuzzSeeds are the inputs of the table tests, which are the seed corpus of the fuzz targets.
+var fuzzSeeds = []string{
+	"",
+	" ",
+	"   ",
+	"abc",
+	"a\U0000FFFDb",
+	"ab\xFFc",
+	"パ\xE3\x83",
+	"a\xED\xA0\x80",
+	"\xC0\xAF",
+	"パピプペポ",
+	"ハ\U0000309Aヒ\U0000309Aフ\U0000309Aヘ\U0000309Aホ\U0000309A",
+	"\U00000061\U00000221\U00000062",
+	"\U00000061\U0000E000",
+	"\U00000061\U0000FDD0",
+	"\U00000061\U00000340",
+	"\U00001D3E\U00001D43\U0001F130",
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	"\U000000DF\U00000130\U000003A3\U00001E9E\U0000FB00\U00001FB6\U00001FBC",
+	"\U00000009\U0000000A\U00000085\U000000A0\U00001680\U00002028\U00003000",
+	"\U000000AD\U0000034F\U0000180B\U0000FE0F\U0000FFFC\U0000200B\U0000FEFF\U000E0001",
+	"a b c",
+	" a  b  c ",
+	"foo bar  ",
+	"\U00000300aa bb",
+	" \U00000300aa bb",
+	" aaa \U00000300bbb",
+	"a  \U00000300b",
+	"  foo   \U00000300bar  ",
+	"  123  456  ",
+	"   \U00000300123  456  ",
+	"  123  456 \U00000300",
+	"-1234-567-",
+	"-1234-\U00000300567-",
+	"-1234567--\U00000300",
+	"+1 512 315 0280",
+	"\U0000FF21\U0000FF22\U0000FF23 \U0000FF11\U0000FF12\U0000FF13",
+	"1234 Main St.$Anytown, CA 12345$USA",
+	`\241,000,000 Sweepstakes$C:\5CTemp`,
+	strings.Repeat("a \U00000300-\U0000FF21\U000000A0", 1<<10),
+}
+
+//fuzzRules returns the names of the matching rules supported by Prepare, in a fixed order.
+func fuzzRules() []string {
+	rules := make([]string, 0, len(ruleProfiles)+2)
+	for name := range ruleProfiles {
+		rules = append(rules, name)
+	}
+	rules = append(rules, CaseIgnoreListMatch, CaseIgnoreListSubstringsMatch)
+	sort.Strings(rules)
+	return rules
+}
+
+//hasMappedCharacter reports whether src has a code point which is mapped to another by m.
+func hasMappedCharacter(src []rune, m stringprep.Mapping) (rune, bool) {
+	for _, c := range src {
+		if _, ok := m[c]; ok {
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+//trimWords removes the SPACE (U+0020) code point which starts a word with combining marks from each of words,
+//which a space handler may add to the first word.
+func trimWords(words [][]rune) [][]rune {
+	dst := make([][]rune, 0, len(words))
+	for _, w := range words {
+		if isSpace(w[0]) {
+			w = w[1:]
+		}
+		dst = append(dst, w)
+	}
+	return dst
+}
+
+//joinRunes concatenates words with sep between them.
+func joinRunes(words [][]rune, sep string) string {
+	s := make([]string, 0, len(words))
+	for _, w := range words {
+		s = append(s, string(w))
+	}
+	return strings.Join(s, sep)
+}
+
+//hasUnassignedCharacter reports whether src has a code point which is unassigned in Unicode 3.2.
+func hasUnassignedCharacter(src []rune) bool {
+	for _, c := range src {
+		if stringprep.TableA1.Contains(c) {
+			return true
+		}
+	}
+	return false
+}
+
+func FuzzPrepare(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s)
+	}
+	rules := fuzzRules()
+	f.Fuzz(func(t *testing.T, s string) {
+		for _, rule := range rules {
+			got, err := Prepare(rule, s)
+			if want := err == nil && string(got) == s; IsPrepared(rule, s) != want {
+				t.Errorf("IsPrepared(%q, %q) = %v, want %v", rule, s, !want, want)
+			}
+			if err != nil {
+				continue
+			}
+			if c, ok := hasMappedCharacter(got, nothingTable); ok {
+				t.Errorf("Prepare(%q, %q) = %q, which has %#U mapped to nothing", rule, s, string(got), c)
+			}
+			if ruleProfiles[rule].caseFolding {
+				//Normalization may compose lower case letters in Table B.2 again, such as U+1FB6, but no upper case letter.
+				if c, ok := hasMappedCharacter(got, stringprep.TableB2); ok && unicode.IsUpper(c) {
+					t.Errorf("Prepare(%q, %q) = %q, which has %#U case folded by Table B.2", rule, s, string(got), c)
+				}
+			}
+			again, err := Prepare(rule, string(got))
+			if err != nil {
+				t.Errorf("Prepare(%q, %q) error = %v, want the prepared value %q", rule, string(got), err, string(got))
+				continue
+			}
+			if !reflect.DeepEqual(again, got) {
+				t.Errorf("Prepare(%q, %q) = %q, want the prepared value %q", rule, string(got), string(again), string(got))
+			}
+		}
+	})
+}
+
+func FuzzMapCharacters(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s, false)
+		f.Add(s, true)
+	}
+	f.Fuzz(func(t *testing.T, s string, caseFolding bool) {
+		got := MapCharacters([]rune(s), caseFolding)
+		if c, ok := hasMappedCharacter(got, nothingTable); ok {
+			t.Errorf("MapCharacters(%q, %v) = %q, which has %#U mapped to nothing", s, caseFolding, string(got), c)
+		}
+		if c, ok := hasMappedCharacter(got, spaceTable); ok && c != 0X0020 {
+			t.Errorf("MapCharacters(%q, %v) = %q, which has %#U mapped to SPACE", s, caseFolding, string(got), c)
+		}
+		if caseFolding {
+			if c, ok := hasMappedCharacter(got, stringprep.TableB2); ok {
+				t.Errorf("MapCharacters(%q, %v) = %q, which has %#U case folded by Table B.2", s, caseFolding, string(got), c)
+			}
+		}
+		if again := MapCharacters(got, caseFolding); !reflect.DeepEqual(again, got) {
+			t.Errorf("MapCharacters(%q, %v) = %q, want the mapped value %q", string(got), caseFolding, string(again), string(got))
+		}
+	})
+}
+
+func FuzzNormalize(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		src := []rune(s)
+		got := Normalize(src)
+		//Code points unassigned in Unicode 3.2 are kept as they are, so only the values without them are NFKC.
+		if !hasUnassignedCharacter(src) && !norm.NFKC.IsNormalString(string(got)) {
+			t.Errorf("Normalize(%q) = %q, which is not NFKC", s, string(got))
+		}
+		if again := Normalize(got); !reflect.DeepEqual(again, got) {
+			t.Errorf("Normalize(%q) = %q, want the normalized value %q", string(got), string(again), string(got))
+		}
+	})
+}
+
+func FuzzIsProhibited(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		src := []rune(s)
+		b, err := IsProhibited(src)
+		if b != (err != nil) {
+			t.Errorf("IsProhibited(%q) = %v, %v, want an error only if prohibited", s, b, err)
+		}
+		for _, c := range src {
+			if cb, _ := isProhibitedCharacter(c); cb && !b {
+				t.Errorf("IsProhibited(%q) = false, but %#U is prohibited", s, c)
+			}
+		}
+	})
+}
+
+func FuzzApplyInsignificantSpaceHandling(f *testing.F) {
+	for _, s := range fuzzSeeds {
+		f.Add(s)
+	}
+	handlers := []struct {
+		name          string
+		handle        func(src []rune) []rune
+		leadingSpace  func(src []rune) bool
+		trailingSpace func(src []rune) bool
+	}{
+		{"ApplyInsignificantSpaceHandling", ApplyInsignificantSpaceHandling,
+			func(src []rune) bool { return true },
+			func(src []rune) bool { return true }},
+		{"ApplyInsignificantSpaceHandlingInitial", ApplyInsignificantSpaceHandlingInitial,
+			func(src []rune) bool { return true },
+			isEndWithSpace},
+		{"ApplyInsignificantSpaceHandlingFinal", ApplyInsignificantSpaceHandlingFinal,
+			isStartWithSpace,
+			func(src []rune) bool { return true }},
+		{"ApplyInsignificantSpaceHandlingAny", ApplyInsignificantSpaceHandlingAny,
+			isStartWithSpace,
+			isEndWithSpace},
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		src := []rune(s)
+		words := trimWords(splitToWords(src))
+		for _, h := range handlers {
+			got := h.handle(src)
+			if again := h.handle(got); !reflect.DeepEqual(again, got) {
+				t.Errorf("%s(%q) = %q, want the handled value %q", h.name, string(got), string(again), string(got))
+			}
+			if gotWords := trimWords(splitToWords(got)); !reflect.DeepEqual(gotWords, words) {
+				t.Errorf("%s(%q) = %q, whose words are %q, want %q", h.name, s, string(got), gotWords, words)
+			}
+			if len(words) == 0 {
+				if string(got) != " " && (h.name != "ApplyInsignificantSpaceHandling" || string(got) != "  ") {
+					t.Errorf("%s(%q) = %q, want spaces for no word", h.name, s, string(got))
+				}
+				continue
+			}
+			if want := h.leadingSpace(src); isStartWithSpace(got) != want {
+				t.Errorf("%s(%q) = %q, want leading space %v", h.name, s, string(got), want)
+			}
+			if want := h.trailingSpace(src); isSpace(got[len(got)-1]) != want {
+				t.Errorf("%s(%q) = %q, want trailing space %v", h.name, s, string(got), want)
+			}
+			inner := got
+			if isStartWithSpace(inner) {
+				inner = inner[1:]
+			}
+			if isSpace(inner[len(inner)-1]) {
+				inner = inner[:len(inner)-1]
+			}
+			if want := joinRunes(splitToWords(got), "  "); string(inner) != want {
+				t.Errorf("%s(%q) = %q, whose words are not separated by two spaces", h.name, s, string(got))
+			}
+		}
+	})
+}
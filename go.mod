@@ -2,4 +2,7 @@ module github.com/tardevnull/ldapstrprep
 
 go 1.22
 
-require golang.org/x/text v0.14.0
+require (
+	golang.org/x/net v0.20.0
+	golang.org/x/text v0.14.0
+)
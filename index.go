@@ -0,0 +1,157 @@
+package ldapstrprep
+
+import (
+	"crypto/sha256"
+	"strings"
+)
+
+//IndexKeyVersion is the first byte of the index keys.
+//It is changed when the index keys of a value change, such as by an update of the string preparation tables.
+const IndexKeyVersion = 1
+
+//Kinds of the index keys, which are the second byte of them.
+const (
+	indexKeyEquality = 'E'
+	indexKeyInitial  = 'I'
+	indexKeyAny      = 'A'
+	indexKeyFinal    = 'F'
+)
+
+//indexKeyHashLen is the length of the hash of the index keys.
+const indexKeyHashLen = 8
+
+//EqualityKey returns the index key of value for the equality matching rule named rule.
+//The key is the version byte, the kind byte and the first 8 octets of the SHA-256 hash of the prepared value,
+//so values which match have the same key. Values which have the same key may not match, and should be compared.
+//Keys do not depend on rule but on the prepared value, so keep the keys of each attribute separately.
+//nil is returned if value cannot be prepared.
+func EqualityKey(rule string, value string) []byte {
+	p, err := Prepare(rule, value)
+	if err != nil {
+		return nil
+	}
+	return indexKey(indexKeyEquality, p)
+}
+
+//SubstringKeys returns the n-gram index keys of value for the substrings matching rule named rule, as OpenLDAP indexes substrings.
+//They are computed over the prepared value, whose spaces are handled by ApplyInsignificantSpaceHandling:
+//the initial keys of the prefixes of length 1 to n, the final keys of the suffixes of length 1 to n,
+//and the any keys of the substrings of length n. Lengths are in runes. The format of keys is described in EqualityKey.
+//The values of the caseIgnoreList matching rules are prepared by PrepareList, and the keys are computed over
+//the unescaped lines, because a substring never matches across two lines: the initial keys of the first line,
+//the final keys of the last line and the any keys of every line.
+//Values which match a substring assertion have all the keys returned by SubstringAssertionKeys.
+//nil is returned if value cannot be prepared or n is less than 1.
+//https://tools.ietf.org/html/rfc4518#section-2.6.1
+func SubstringKeys(rule string, value string, n int) [][]byte {
+	if n < 1 {
+		return nil
+	}
+	lines, err := prepareSubstringLines(rule, value)
+	if err != nil {
+		return nil
+	}
+	keys := newIndexKeys()
+	first, last := lines[0], lines[len(lines)-1]
+	for i := 1; i <= n && i <= len(first); i++ {
+		keys.add(indexKeyInitial, first[:i])
+	}
+	for _, p := range lines {
+		for i := 0; i+n <= len(p); i++ {
+			keys.add(indexKeyAny, p[i:i+n])
+		}
+	}
+	for i := 1; i <= n && i <= len(last); i++ {
+		keys.add(indexKeyFinal, last[len(last)-i:])
+	}
+	return keys.keys
+}
+
+//prepareSubstringLines prepares value for the matching rule named rule, and returns its lines, which are the lines
+//of PrepareList for the caseIgnoreList matching rules, or the prepared value alone for the other matching rules.
+func prepareSubstringLines(rule string, value string) ([][]rune, error) {
+	name, err := lookupRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	if name == strings.ToLower(CaseIgnoreListMatch) || name == strings.ToLower(CaseIgnoreListSubstringsMatch) {
+		return PrepareList(value)
+	}
+	p, err := Prepare(rule, value)
+	if err != nil {
+		return nil, err
+	}
+	return [][]rune{p}, nil
+}
+
+//SubstringAssertionKeys returns the index keys which the values matching assertion have, such as "foo*bar*baz".
+//The keys are looked up in the keys returned by SubstringKeys with the same rule and n.
+//The initial and final substrings give the keys of their first and last n runes,
+//and the any substrings give the keys of their substrings of length n. Shorter any substrings give no keys.
+//nil is returned if assertion cannot be parsed or prepared, or n is less than 1.
+func SubstringAssertionKeys(rule string, assertion string, n int) [][]byte {
+	if n < 1 {
+		return nil
+	}
+	a, err := ParseSubstringAssertion(assertion)
+	if err != nil {
+		return nil
+	}
+	keys := newIndexKeys()
+	if a.Initial != "" {
+		p, err := PrepareSubstring(rule, a.Initial, SubstringInitial)
+		if err != nil {
+			return nil
+		}
+		if len(p) != 0 {
+			keys.add(indexKeyInitial, p[:min(n, len(p))])
+		}
+	}
+	for _, s := range a.Any {
+		p, err := PrepareSubstring(rule, s, SubstringAny)
+		if err != nil {
+			return nil
+		}
+		for i := 0; i+n <= len(p); i++ {
+			keys.add(indexKeyAny, p[i:i+n])
+		}
+	}
+	if a.Final != "" {
+		p, err := PrepareSubstring(rule, a.Final, SubstringFinal)
+		if err != nil {
+			return nil
+		}
+		if len(p) != 0 {
+			keys.add(indexKeyFinal, p[len(p)-min(n, len(p)):])
+		}
+	}
+	return keys.keys
+}
+
+//indexKeys collects distinct index keys in the order they are added.
+type indexKeys struct {
+	keys [][]byte
+	seen map[string]struct{}
+}
+
+func newIndexKeys() *indexKeys {
+	return &indexKeys{keys: make([][]byte, 0, 0), seen: make(map[string]struct{})}
+}
+
+//add adds the index key of kind for r, unless it has been added.
+func (k *indexKeys) add(kind byte, r []rune) {
+	key := indexKey(kind, r)
+	if _, ok := k.seen[string(key)]; ok {
+		return
+	}
+	k.seen[string(key)] = struct{}{}
+	k.keys = append(k.keys, key)
+}
+
+//indexKey returns the index key of kind for r.
+func indexKey(kind byte, r []rune) []byte {
+	sum := sha256.Sum256([]byte(string(r)))
+	key := make([]byte, 0, 2+indexKeyHashLen)
+	key = append(key, IndexKeyVersion, kind)
+	return append(key, sum[:indexKeyHashLen]...)
+}
@@ -0,0 +1,143 @@
+package ldapstrprep
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func TestEqualityKey(t *testing.T) {
+	type args struct {
+		rule  string
+		value string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"TestCase:caseIgnoreMatch", args{CaseIgnoreMatch, "abc"}, "0145" + hex.EncodeToString(sha256Prefix(" abc "))},
+		{"TestCase:fullwidth and spaces", args{CaseIgnoreMatch, "  ＡＢＣ "}, "0145" + hex.EncodeToString(sha256Prefix(" abc "))},
+		{"TestCase:telephoneNumberMatch", args{TelephoneNumberMatch, "+1 555-0100"}, "0145" + hex.EncodeToString(sha256Prefix("+15550100"))},
+		{"TestCase:prohibited", args{CaseIgnoreMatch, "a\U0000E000"}, ""},
+		{"TestCase:unsupported rule", args{"integerMatch", "1"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hex.EncodeToString(EqualityKey(tt.args.rule, tt.args.value)); got != tt.want {
+				t.Errorf("EqualityKey() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if k := EqualityKey(CaseIgnoreMatch, "abc"); len(k) != 10 || k[0] != IndexKeyVersion {
+		t.Errorf("EqualityKey() = %x", k)
+	}
+	if hex.EncodeToString(EqualityKey(CaseIgnoreMatch, "abc")) != "01453eaf1941003943df" {
+		t.Errorf("EqualityKey() = %x, the format of keys has changed without changing IndexKeyVersion", EqualityKey(CaseIgnoreMatch, "abc"))
+	}
+	if bytes.Equal(EqualityKey(CaseIgnoreMatch, "abc"), EqualityKey(CaseExactMatch, "ABC")) {
+		t.Errorf("EqualityKey() of different prepared values are equal")
+	}
+}
+
+//sha256Prefix returns the first octets of the SHA-256 hash of s which index keys have.
+func sha256Prefix(s string) []byte {
+	return indexKey(0, []rune(s))[2:]
+}
+
+func TestSubstringKeys(t *testing.T) {
+	type args struct {
+		rule  string
+		value string
+		n     int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{"TestCase:n is 3", args{CaseIgnoreSubstringsMatch, "ABC", 3}, []string{"I ", "I a", "I ab", "A ab", "Aabc", "Abc ", "F ", "Fc ", "Fbc "}},
+		{"TestCase:value shorter than n", args{NumericStringSubstringsMatch, "1 2", 3}, []string{"I1", "I12", "F2", "F12"}},
+		{"TestCase:duplicate n-grams", args{NumericStringSubstringsMatch, "1111", 2}, []string{"I1", "I11", "A11", "F1", "F11"}},
+		{"TestCase:caseIgnoreListSubstringsMatch", args{CaseIgnoreListSubstringsMatch, "A\\24$BC", 3}, []string{"I ", "I a", "I a$", "A a$", "Aa$ ", "A bc", "Abc ", "F ", "Fc ", "Fbc "}},
+		{"TestCase:n is 0", args{CaseIgnoreSubstringsMatch, "ABC", 0}, nil},
+		{"TestCase:prohibited", args{CaseIgnoreSubstringsMatch, "a\U0000E000", 3}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SubstringKeys(tt.args.rule, tt.args.value, tt.args.n)
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("SubstringKeys() = %x, want nil", got)
+				}
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("SubstringKeys() = %x, want %q", got, tt.want)
+			}
+			for i, w := range tt.want {
+				if !bytes.Equal(got[i], indexKey(w[0], []rune(w[1:]))) {
+					t.Errorf("SubstringKeys()[%d] = %x, want key of %q", i, got[i], w)
+				}
+			}
+		})
+	}
+}
+
+func TestSubstringAssertionKeys(t *testing.T) {
+	values := []string{"John  Smith", "ＪＯＨＮ", "Jonathan Smithers", "Smith John", "a", ""}
+	assertions := []string{"john*", "*smith", "jo*th*", "*ohn*", "j*h*s*h", "*n  s*", "a*", "*a", "x*"}
+	for _, n := range []int{1, 2, 3, 4} {
+		for _, v := range values {
+			keys := make(map[string]struct{})
+			for _, k := range SubstringKeys(CaseIgnoreSubstringsMatch, v, n) {
+				keys[string(k)] = struct{}{}
+			}
+			for _, s := range assertions {
+				a, err := ParseSubstringAssertion(s)
+				if err != nil {
+					t.Fatal(err)
+				}
+				p, err := prepareSubstrings(a, true)
+				if err != nil {
+					t.Fatal(err)
+				}
+				pv, err := Prepare(CaseIgnoreSubstringsMatch, v)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !matchSubstrings([][]rune{pv}, p) {
+					continue
+				}
+				for _, k := range SubstringAssertionKeys(CaseIgnoreSubstringsMatch, s, n) {
+					if _, ok := keys[string(k)]; !ok {
+						t.Errorf("n = %d: %q matches %q, but does not have the key %x", n, v, s, k)
+					}
+				}
+			}
+		}
+	}
+	listKeys := make(map[string]struct{})
+	for _, k := range SubstringKeys(CaseIgnoreListSubstringsMatch, "Price \\2410$Main St", 3) {
+		listKeys[string(k)] = struct{}{}
+	}
+	for _, s := range []string{"price $10*", "*$10*", "*ce $1*main*", "*st"} {
+		if ok, err := MatchCaseIgnoreListSubstrings("Price \\2410$Main St", s); err != nil || !ok {
+			t.Fatalf("MatchCaseIgnoreListSubstrings(%q) = %v, %v", s, ok, err)
+		}
+		for _, k := range SubstringAssertionKeys(CaseIgnoreListSubstringsMatch, s, 3) {
+			if _, ok := listKeys[string(k)]; !ok {
+				t.Errorf("%q matches the list value, but it does not have the key %x", s, k)
+			}
+		}
+	}
+	if got := SubstringAssertionKeys(CaseIgnoreSubstringsMatch, "ab*cdef*g", 3); len(got) != 4 {
+		t.Errorf("SubstringAssertionKeys() = %x, want 4 keys", got)
+	}
+	if got := SubstringAssertionKeys(CaseIgnoreSubstringsMatch, "**", 3); got != nil {
+		t.Errorf("SubstringAssertionKeys() = %x, want nil", got)
+	}
+	if got := SubstringAssertionKeys(CaseIgnoreSubstringsMatch, "a*", 0); got != nil {
+		t.Errorf("SubstringAssertionKeys() = %x, want nil", got)
+	}
+}
@@ -1,10 +1,51 @@
 package ldapstrprep
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 )
 
+func TestTranscodeStrict(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       []rune
+		wantErr    bool
+		wantOffset int
+	}{
+		{"TestCase:ASCII", args{"abc"}, []rune("abc"), false, 0},
+		{"TestCase:blank", args{""}, []rune{}, false, 0},
+		{"TestCase:U+FFFD", args{"a\U0000FFFDb"}, []rune("a\U0000FFFDb"), false, 0},
+		{"TestCase:Invalid start byte", args{"ab\xFFc"}, nil, true, 2},
+		{"TestCase:Truncated sequence", args{"パ\xE3\x83"}, nil, true, 3},
+		{"TestCase:Surrogate", args{"a\xED\xA0\x80"}, nil, true, 1},
+		{"TestCase:Overlong encoding", args{"\xC0\xAF"}, nil, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TranscodeStrict(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TranscodeStrict() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				var encErr *InvalidEncodingError
+				if !errors.As(err, &encErr) || encErr.Offset != tt.wantOffset {
+					t.Errorf("TranscodeStrict() error = %v, want InvalidEncodingError at offset %d", err, tt.wantOffset)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TranscodeStrict() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNormalize(t *testing.T) {
 	type args struct {
 		input []rune
@@ -16,6 +57,7 @@ func TestNormalize(t *testing.T) {
 	}{
 		{"TestCase:Normalized character as NFKC", args{[]rune("パピプペポ")}, []rune("パピプペポ")},
 		{"TestCase:Not normalized character as NFKC", args{[]rune{'ハ', '゚', 'ヒ', '゚', 'フ', '゚', 'ヘ', '゚', 'ホ', '゚'}}, []rune("パピプペポ")},
+		{"TestCase:Unassigned in Unicode 3.2", args{[]rune("\U00001D3E")}, []rune("\U00001D3E")},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1335,6 +1377,7 @@ func TestApplyInsignificantSpaceHandling(t *testing.T) {
 		{"TestCase:foo bar", args{[]rune("foo bar")}, []rune(" foo  bar ")},
 		{"TestCase:foo bar  ", args{[]rune("foo bar  ")}, []rune(" foo  bar ")},
 		{"TestCase: <CM>foo bar", args{[]rune(" \U00000300foo bar")}, []rune("  \U00000300foo  bar ")},
+		{"TestCase:<CM>foo bar", args{[]rune("\U00000300foo bar")}, []rune("  \U00000300foo  bar ")},
 		{"TestCase: foo <CM>bar", args{[]rune(" foo \U00000300bar")}, []rune(" foo \U00000300bar ")},
 		{"TestCase: foo  <CM>bar", args{[]rune(" foo  \U00000300bar")}, []rune(" foo   \U00000300bar ")},
 	}
@@ -5286,6 +5329,7 @@ func TestApplyInsignificantSpaceHandlingInitial(t *testing.T) {
 		{"TestCase:foo <CM>bar", args{[]rune("foo \U00000300bar")}, []rune(" foo \U00000300bar")},
 		{"TestCase:foo bar  ", args{[]rune("foo bar  ")}, []rune(" foo  bar ")},
 		{"TestCase: <CM>foo bar  ", args{[]rune(" \U00000300foo bar  ")}, []rune("  \U00000300foo  bar ")},
+		{"TestCase:<CM>foo bar", args{[]rune("\U00000300foo bar")}, []rune("  \U00000300foo  bar")},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -5310,6 +5354,7 @@ func TestApplyInsignificantSpaceHandlingFinal(t *testing.T) {
 		{"TestCase:foo bar", args{[]rune("foo bar")}, []rune("foo  bar ")},
 		{"TestCase:  foo bar", args{[]rune("  foo bar")}, []rune(" foo  bar ")},
 		{"TestCase: <CM>a b  ", args{[]rune(" \U00000300a b  ")}, []rune(" \U00000300a  b ")},
+		{"TestCase:  <CM>a b", args{[]rune("  \U00000300a b")}, []rune("  \U00000300a  b ")},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -5335,6 +5380,7 @@ func TestApplyInsignificantSpaceHandlingAny(t *testing.T) {
 		{"TestCase:foo bar", args{[]rune("foo bar")}, []rune("foo  bar")},
 		{"TestCase:  foo   <CM>bar  ", args{[]rune("  foo   \U00000300bar  ")}, []rune(" foo   \U00000300bar ")},
 		{"TestCase: <CM>a b  ", args{[]rune(" \U00000300a b  ")}, []rune(" \U00000300a  b ")},
+		{"TestCase:  <CM>a b", args{[]rune("  \U00000300a b")}, []rune("  \U00000300a  b")},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
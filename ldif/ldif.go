@@ -0,0 +1,126 @@
+//Package ldif implements reading and writing of the LDAP Data Interchange Format (LDIF) described in RFC 2849.
+/*
+
+Both content records and change records are supported. A Record is a content record if its ChangeType is empty.
+
+Values of Record are raw octets. Values which are base64-encoded (attr:: value) in LDIF are decoded,
+and values which are referenced by file URLs (attr:< file:///path) are read from the files.
+
+Reader:
+
+  r := ldif.NewReader(f)
+  for {
+    rec, err := r.Read()
+    if err == io.EOF {
+      break
+    }
+    ...
+  }
+
+Writer:
+
+  w := ldif.NewWriter(os.Stdout)
+  err := w.Write(rec)
+  ...
+  err = w.Flush()
+
+*/
+package ldif
+
+import (
+	"fmt"
+	"strings"
+)
+
+//Change types of change records.
+//https://tools.ietf.org/html/rfc2849#section-2
+const (
+	ChangeTypeAdd    = "add"
+	ChangeTypeDelete = "delete"
+	ChangeTypeModify = "modify"
+	ChangeTypeModRDN = "modrdn"
+	ChangeTypeModDN  = "moddn"
+)
+
+//Operations of the modifications of modify change records.
+const (
+	ModAdd       = "add"
+	ModDelete    = "delete"
+	ModReplace   = "replace"
+	ModIncrement = "increment"
+)
+
+//Attribute is an attribute of a content record or an add change record.
+//Type is the attribute description, which may have options such as "cn;lang-ja".
+//Lines are the line numbers where the Values start. They are nil for attributes which are not read by Reader.
+type Attribute struct {
+	Type   string
+	Values []string
+	Lines  []int
+}
+
+//Modification is a modification of a modify change record.
+//Operation is one of ModAdd, ModDelete, ModReplace and ModIncrement.
+//Values may be empty for ModDelete and ModReplace.
+//Lines are the line numbers where the Values start. They are nil for modifications which are not read by Reader.
+type Modification struct {
+	Operation string
+	Type      string
+	Values    []string
+	Lines     []int
+}
+
+//Control is an LDAP control of a change record.
+//Value is meaningful only if HasValue is true.
+type Control struct {
+	OID         string
+	Criticality bool
+	HasValue    bool
+	Value       string
+}
+
+//Record is a content record or a change record.
+//Attributes is used by content records and add change records, Modifications by modify change records,
+//and NewRDN, DeleteOldRDN and NewSuperior by modrdn and moddn change records.
+//Line is the line number where the record starts. It is zero for records which are not read by Reader.
+type Record struct {
+	DN            string
+	Line          int
+	Controls      []Control
+	ChangeType    string
+	Attributes    []Attribute
+	Modifications []Modification
+	NewRDN        string
+	DeleteOldRDN  bool
+	NewSuperior   string
+}
+
+//IsChange reports whether rec is a change record.
+func (rec *Record) IsChange() bool {
+	return rec.ChangeType != ""
+}
+
+//Values returns the values of the attribute attr of rec. attr is compared case-insensitively, including its options.
+func (rec *Record) Values(attr string) []string {
+	for _, a := range rec.Attributes {
+		if strings.EqualFold(a.Type, attr) {
+			return a.Values
+		}
+	}
+	return nil
+}
+
+//ParseError is an error which occurred while reading LDIF.
+//Line is the line number where the erroneous line starts.
+type ParseError struct {
+	Line int
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("ldif: line %d: %v", e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
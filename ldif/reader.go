@@ -0,0 +1,364 @@
+package ldif
+
+import (
+	"bufio"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+)
+
+//Reader reads records from LDIF.
+type Reader struct {
+	r       *bufio.Reader
+	n       int
+	version int
+	started bool
+}
+
+//line is a line of LDIF which is unfolded. n is the line number where it starts.
+type line struct {
+	text string
+	n    int
+}
+
+//NewReader returns a Reader which reads from r.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+//Version returns the version of LDIF, which is 1 if the version line has been read, or 0 otherwise.
+func (r *Reader) Version() int {
+	return r.version
+}
+
+//Read reads the next record. io.EOF is returned if there are no more records.
+//Errors in the contents are returned as *ParseError.
+//https://tools.ietf.org/html/rfc2849#section-2
+func (r *Reader) Read() (*Record, error) {
+	for {
+		lines, err := r.readLines()
+		if err != nil {
+			return nil, err
+		}
+		if len(lines) == 0 {
+			return nil, io.EOF
+		}
+		if !r.started {
+			r.started = true
+			if attr, v, ok := strings.Cut(lines[0].text, ":"); ok && strings.EqualFold(attr, "version") {
+				if strings.TrimSpace(v) != "1" {
+					return nil, &ParseError{Line: lines[0].n, Err: fmt.Errorf("unsupported version %q", strings.TrimSpace(v))}
+				}
+				r.version = 1
+				if lines = lines[1:]; len(lines) == 0 {
+					continue
+				}
+			}
+		}
+		return parseRecord(lines)
+	}
+}
+
+//ReadAll reads all the remaining records.
+func (r *Reader) ReadAll() ([]*Record, error) {
+	recs := make([]*Record, 0, 0)
+	for {
+		rec, err := r.Read()
+		if err == io.EOF {
+			return recs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		recs = append(recs, rec)
+	}
+}
+
+//readLines reads the unfolded lines of the next record, skipping comments and empty lines before it.
+//The lines are empty at the end of LDIF.
+func (r *Reader) readLines() ([]line, error) {
+	lines := make([]line, 0, 0)
+	inComment := false
+	for {
+		s, err := r.r.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if err == io.EOF && s == "" {
+			return lines, nil
+		}
+		r.n++
+		s = strings.TrimSuffix(strings.TrimSuffix(s, "\n"), "\r")
+		switch {
+		case s == "":
+			if len(lines) > 0 {
+				return lines, nil
+			}
+			inComment = false
+		case strings.HasPrefix(s, " "):
+			if inComment {
+				continue
+			}
+			if len(lines) == 0 {
+				return nil, &ParseError{Line: r.n, Err: errors.New("continuation line without preceding line")}
+			}
+			lines[len(lines)-1].text += s[1:]
+		case strings.HasPrefix(s, "#"):
+			inComment = true
+		default:
+			inComment = false
+			lines = append(lines, line{text: s, n: r.n})
+		}
+		if err == io.EOF {
+			return lines, nil
+		}
+	}
+}
+
+//parseRecord parses the unfolded lines of a record.
+func parseRecord(lines []line) (*Record, error) {
+	attr, dn, err := parseAttrValSpec(lines[0].text)
+	if err != nil {
+		return nil, &ParseError{Line: lines[0].n, Err: err}
+	}
+	if !strings.EqualFold(attr, "dn") {
+		return nil, &ParseError{Line: lines[0].n, Err: fmt.Errorf("record starts with %q instead of dn", attr)}
+	}
+	rec := &Record{DN: dn, Line: lines[0].n, Controls: make([]Control, 0, 0)}
+	lines = lines[1:]
+	for len(lines) > 0 {
+		attr, v, _ := strings.Cut(lines[0].text, ":")
+		if !strings.EqualFold(attr, "control") {
+			break
+		}
+		c, err := parseControl(v)
+		if err != nil {
+			return nil, &ParseError{Line: lines[0].n, Err: err}
+		}
+		rec.Controls = append(rec.Controls, c)
+		lines = lines[1:]
+	}
+	if len(lines) > 0 {
+		if attr, v, _ := strings.Cut(lines[0].text, ":"); strings.EqualFold(attr, "changetype") {
+			rec.ChangeType = strings.ToLower(strings.TrimSpace(v))
+			if err := parseChange(rec, lines[0].n, lines[1:]); err != nil {
+				return nil, err
+			}
+			return rec, nil
+		}
+	}
+	if len(rec.Controls) > 0 {
+		return nil, &ParseError{Line: rec.Line, Err: errors.New("content record has controls")}
+	}
+	if rec.Attributes, err = parseAttributes(rec.Line, lines); err != nil {
+		return nil, err
+	}
+	return rec, nil
+}
+
+//parseChange parses the lines following the changetype line of the change record rec. n is the line number of the changetype line.
+func parseChange(rec *Record, n int, lines []line) error {
+	var err error
+	switch rec.ChangeType {
+	case ChangeTypeAdd:
+		rec.Attributes, err = parseAttributes(n, lines)
+		return err
+	case ChangeTypeDelete:
+		if len(lines) > 0 {
+			return &ParseError{Line: lines[0].n, Err: errors.New("delete change record has attributes")}
+		}
+		return nil
+	case ChangeTypeModify:
+		rec.Modifications, err = parseModifications(lines)
+		return err
+	case ChangeTypeModRDN, ChangeTypeModDN:
+		return parseModRDN(rec, n, lines)
+	default:
+		return &ParseError{Line: n, Err: fmt.Errorf("unknown changetype %q", rec.ChangeType)}
+	}
+}
+
+//parseAttributes parses lines which are attrval-specs. Values of the same attribute are merged.
+//n is the line number reported if lines are empty.
+func parseAttributes(n int, lines []line) ([]Attribute, error) {
+	if len(lines) == 0 {
+		return nil, &ParseError{Line: n, Err: errors.New("record has no attributes")}
+	}
+	attrs := make([]Attribute, 0, 0)
+	index := make(map[string]int)
+	for _, l := range lines {
+		attr, v, err := parseAttrValSpec(l.text)
+		if err != nil {
+			return nil, &ParseError{Line: l.n, Err: err}
+		}
+		key := strings.ToLower(attr)
+		i, ok := index[key]
+		if !ok {
+			i = len(attrs)
+			index[key] = i
+			attrs = append(attrs, Attribute{Type: attr, Values: make([]string, 0, 0), Lines: make([]int, 0, 0)})
+		}
+		attrs[i].Values = append(attrs[i].Values, v)
+		attrs[i].Lines = append(attrs[i].Lines, l.n)
+	}
+	return attrs, nil
+}
+
+//parseModifications parses the mod-specs of a modify change record.
+func parseModifications(lines []line) ([]Modification, error) {
+	mods := make([]Modification, 0, 0)
+	for len(lines) > 0 {
+		op, typ, err := parseAttrValSpec(lines[0].text)
+		if err != nil {
+			return nil, &ParseError{Line: lines[0].n, Err: err}
+		}
+		op = strings.ToLower(op)
+		switch op {
+		case ModAdd, ModDelete, ModReplace, ModIncrement:
+		default:
+			return nil, &ParseError{Line: lines[0].n, Err: fmt.Errorf("unknown modification %q", op)}
+		}
+		mod := Modification{Operation: op, Type: strings.TrimSpace(typ), Values: make([]string, 0, 0), Lines: make([]int, 0, 0)}
+		start := lines[0].n
+		for lines = lines[1:]; len(lines) > 0 && lines[0].text != "-"; lines = lines[1:] {
+			attr, v, err := parseAttrValSpec(lines[0].text)
+			if err != nil {
+				return nil, &ParseError{Line: lines[0].n, Err: err}
+			}
+			if !strings.EqualFold(attr, mod.Type) {
+				return nil, &ParseError{Line: lines[0].n, Err: fmt.Errorf("attribute %q does not match modification of %q", attr, mod.Type)}
+			}
+			mod.Values = append(mod.Values, v)
+			mod.Lines = append(mod.Lines, lines[0].n)
+		}
+		if len(lines) == 0 {
+			return nil, &ParseError{Line: start, Err: errors.New(`modification is not terminated by "-"`)}
+		}
+		lines = lines[1:]
+		mods = append(mods, mod)
+	}
+	return mods, nil
+}
+
+//parseModRDN parses the lines of a modrdn or moddn change record.
+//n is the line number of the changetype line.
+func parseModRDN(rec *Record, n int, lines []line) error {
+	hasNewRDN, hasDeleteOldRDN := false, false
+	for _, l := range lines {
+		attr, v, err := parseAttrValSpec(l.text)
+		if err != nil {
+			return &ParseError{Line: l.n, Err: err}
+		}
+		switch strings.ToLower(attr) {
+		case "newrdn":
+			rec.NewRDN, hasNewRDN = v, true
+		case "deleteoldrdn":
+			switch v {
+			case "0":
+				rec.DeleteOldRDN = false
+			case "1":
+				rec.DeleteOldRDN = true
+			default:
+				return &ParseError{Line: l.n, Err: fmt.Errorf("invalid deleteoldrdn %q", v)}
+			}
+			hasDeleteOldRDN = true
+		case "newsuperior":
+			rec.NewSuperior = v
+		default:
+			return &ParseError{Line: l.n, Err: fmt.Errorf("unexpected %q in %s change record", attr, rec.ChangeType)}
+		}
+	}
+	if !hasNewRDN || !hasDeleteOldRDN {
+		return &ParseError{Line: n, Err: fmt.Errorf("%s change record requires newrdn and deleteoldrdn", rec.ChangeType)}
+	}
+	return nil
+}
+
+//parseControl parses spec, which follows "control:" of a control line, such as " 1.2.3 true:: dmFsdWU=".
+func parseControl(spec string) (Control, error) {
+	spec = strings.TrimLeft(spec, " ")
+	i := strings.IndexAny(spec, " :")
+	if i < 0 {
+		i = len(spec)
+	}
+	c := Control{OID: spec[:i]}
+	if c.OID == "" {
+		return Control{}, errors.New("control has no OID")
+	}
+	rest := strings.TrimLeft(spec[i:], " ")
+	switch {
+	case strings.HasPrefix(rest, "true"):
+		c.Criticality, rest = true, rest[len("true"):]
+	case strings.HasPrefix(rest, "false"):
+		rest = rest[len("false"):]
+	}
+	rest = strings.TrimLeft(rest, " ")
+	if rest == "" {
+		return c, nil
+	}
+	if rest[0] != ':' {
+		return Control{}, fmt.Errorf("invalid control %q", spec)
+	}
+	v, err := parseValueSpec(rest[1:])
+	if err != nil {
+		return Control{}, err
+	}
+	c.HasValue, c.Value = true, v
+	return c, nil
+}
+
+//parseAttrValSpec parses spec, which is an unfolded attrval-spec such as "cn: foo", "cn:: Zm9v" or "cn:< file:///foo".
+func parseAttrValSpec(spec string) (attr string, value string, err error) {
+	attr, v, ok := strings.Cut(spec, ":")
+	if !ok || attr == "" || strings.ContainsAny(attr, " \t") {
+		return "", "", fmt.Errorf("invalid line %q", spec)
+	}
+	if value, err = parseValueSpec(v); err != nil {
+		return "", "", fmt.Errorf("value of %s: %w", attr, err)
+	}
+	return attr, value, nil
+}
+
+//parseValueSpec parses spec, which follows the first colon of an attrval-spec.
+//Base64 values are decoded, and file URLs are read.
+func parseValueSpec(spec string) (string, error) {
+	switch {
+	case strings.HasPrefix(spec, ":"):
+		b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(spec[1:]))
+		if err != nil {
+			return "", fmt.Errorf("invalid base64: %v", err)
+		}
+		return string(b), nil
+	case strings.HasPrefix(spec, "<"):
+		return readURL(strings.TrimSpace(spec[1:]))
+	default:
+		return strings.TrimLeft(spec, " "), nil
+	}
+}
+
+//readURL reads the contents of the file referenced by the file URL s. Other schemes are not supported.
+func readURL(s string) (string, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "file" {
+		return "", fmt.Errorf("URL scheme %q is not supported", u.Scheme)
+	}
+	if u.Host != "" && u.Host != "localhost" {
+		return "", fmt.Errorf("file URL of host %q is not supported", u.Host)
+	}
+	path := u.Path
+	if path == "" {
+		path = u.Opaque
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,111 @@
+package ldif
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReader_Read(t *testing.T) {
+	dir := t.TempDir()
+	photo := filepath.Join(dir, "photo.jpg")
+	if err := os.WriteFile(photo, []byte{0XFF, 0XD8, 0XFF}, 0600); err != nil {
+		t.Fatal(err)
+	}
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        []*Record
+		wantVersion int
+		wantErr     bool
+	}{
+		{"TestCase:Content records with version", args{"version: 1\ndn: cn=Foo,dc=example,dc=com\nobjectClass: top\nobjectClass: person\ncn: Foo\n\n\ndn: cn=Bar,dc=example,dc=com\ncn: Bar\n"}, []*Record{
+			{DN: "cn=Foo,dc=example,dc=com", Line: 2, Controls: []Control{}, Attributes: []Attribute{{Type: "objectClass", Values: []string{"top", "person"}, Lines: []int{3, 4}}, {Type: "cn", Values: []string{"Foo"}, Lines: []int{5}}}},
+			{DN: "cn=Bar,dc=example,dc=com", Line: 8, Controls: []Control{}, Attributes: []Attribute{{Type: "cn", Values: []string{"Bar"}, Lines: []int{9}}}},
+		}, 1, false},
+		{"TestCase:Version line followed by an empty line", args{"version: 1\n\ndn: cn=Foo\ncn: Foo\n"}, []*Record{
+			{DN: "cn=Foo", Line: 3, Controls: []Control{}, Attributes: []Attribute{{Type: "cn", Values: []string{"Foo"}, Lines: []int{4}}}},
+		}, 1, false},
+		{"TestCase:Folding, comments, CRLF and values of the same attribute", args{"# comment\n #  continued\r\ndn: cn=Foo,dc=exa\r\n mple,dc=com\r\n# another\r\nCN: Foo\r\ncn: B\r\n  ar\r\ndescription:\r\n"}, []*Record{
+			{DN: "cn=Foo,dc=example,dc=com", Line: 3, Controls: []Control{}, Attributes: []Attribute{{Type: "CN", Values: []string{"Foo", "B ar"}, Lines: []int{6, 7}}, {Type: "description", Values: []string{""}, Lines: []int{9}}}},
+		}, 0, false},
+		{"TestCase:Base64 and file URL", args{"dn:: Y249w5xiZXI=\ncn:: w5xiZXI=\njpegPhoto:< file://" + filepath.ToSlash(photo) + "\n"}, []*Record{
+			{DN: "cn=Über", Line: 1, Controls: []Control{}, Attributes: []Attribute{{Type: "cn", Values: []string{"Über"}, Lines: []int{2}}, {Type: "jpegPhoto", Values: []string{"\xFF\xD8\xFF"}, Lines: []int{3}}}},
+		}, 0, false},
+		{"TestCase:Change records", args{"dn: cn=Foo\ncontrol: 1.2.840.113556.1.4.805 true\ncontrol: 1.2.3:: dmFsdWU=\nchangetype: delete\n\n" +
+			"dn: cn=Bar\nchangetype: add\ncn: Bar\nsn: Bar\n\n" +
+			"dn: cn=Baz\nchangetype: modify\nadd: mail\nmail: baz@example.com\n-\ndelete: description\n-\nreplace: sn\nsn: Baz\nsn: BAZ\n-\n\n" +
+			"dn: cn=Qux\nchangetype: modrdn\nnewrdn: cn=Quux\ndeleteoldrdn: 1\nnewsuperior: dc=example\n\n" +
+			"dn: cn=Quux\nchangetype: moddn\nnewrdn:: Y249UXV1eA==\ndeleteoldrdn: 0\n"}, []*Record{
+			{DN: "cn=Foo", Line: 1, Controls: []Control{{OID: "1.2.840.113556.1.4.805", Criticality: true}, {OID: "1.2.3", HasValue: true, Value: "value"}}, ChangeType: ChangeTypeDelete},
+			{DN: "cn=Bar", Line: 6, Controls: []Control{}, ChangeType: ChangeTypeAdd, Attributes: []Attribute{{Type: "cn", Values: []string{"Bar"}, Lines: []int{8}}, {Type: "sn", Values: []string{"Bar"}, Lines: []int{9}}}},
+			{DN: "cn=Baz", Line: 11, Controls: []Control{}, ChangeType: ChangeTypeModify, Modifications: []Modification{
+				{Operation: ModAdd, Type: "mail", Values: []string{"baz@example.com"}, Lines: []int{14}},
+				{Operation: ModDelete, Type: "description", Values: []string{}, Lines: []int{}},
+				{Operation: ModReplace, Type: "sn", Values: []string{"Baz", "BAZ"}, Lines: []int{19, 20}},
+			}},
+			{DN: "cn=Qux", Line: 23, Controls: []Control{}, ChangeType: ChangeTypeModRDN, NewRDN: "cn=Quux", DeleteOldRDN: true, NewSuperior: "dc=example"},
+			{DN: "cn=Quux", Line: 29, Controls: []Control{}, ChangeType: ChangeTypeModDN, NewRDN: "cn=Quux", DeleteOldRDN: false},
+		}, 0, false},
+		{"TestCase:Empty", args{"\n# comment\n\n"}, []*Record{}, 0, false},
+		{"TestCase:Unsupported version", args{"version: 2\ndn: cn=Foo\ncn: Foo\n"}, nil, 0, true},
+		{"TestCase:Record without dn", args{"cn: Foo\n"}, nil, 0, true},
+		{"TestCase:Content record without attributes", args{"dn: cn=Foo\n"}, nil, 0, true},
+		{"TestCase:Continuation line without preceding line", args{" cn: Foo\n"}, nil, 0, true},
+		{"TestCase:Invalid base64", args{"dn: cn=Foo\ncn:: !!\n"}, nil, 0, true},
+		{"TestCase:Unsupported URL scheme", args{"dn: cn=Foo\njpegPhoto:< http://example.com/photo.jpg\n"}, nil, 0, true},
+		{"TestCase:Unknown changetype", args{"dn: cn=Foo\nchangetype: rename\n"}, nil, 0, true},
+		{"TestCase:Delete with attributes", args{"dn: cn=Foo\nchangetype: delete\ncn: Foo\n"}, nil, 0, true},
+		{"TestCase:Modification without -", args{"dn: cn=Foo\nchangetype: modify\nreplace: sn\nsn: Foo\n"}, nil, 0, true},
+		{"TestCase:Modification of another attribute", args{"dn: cn=Foo\nchangetype: modify\nreplace: sn\ncn: Foo\n-\n"}, nil, 0, true},
+		{"TestCase:modrdn without deleteoldrdn", args{"dn: cn=Foo\nchangetype: modrdn\nnewrdn: cn=Bar\n"}, nil, 0, true},
+		{"TestCase:Content record with control", args{"dn: cn=Foo\ncontrol: 1.2.3\ncn: Foo\n"}, nil, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReader(strings.NewReader(tt.args.s))
+			got, err := r.ReadAll()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ReadAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				var pe *ParseError
+				if !errors.As(err, &pe) {
+					t.Errorf("ReadAll() error = %v, want *ParseError", err)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReadAll() got = %+v, want %+v", got, tt.want)
+			}
+			if r.Version() != tt.wantVersion {
+				t.Errorf("Version() = %v, want %v", r.Version(), tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestReader_ReadEOF(t *testing.T) {
+	r := NewReader(strings.NewReader("dn: cn=Foo\ncn: Foo"))
+	if _, err := r.Read(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("Read() error = %v, want io.EOF", err)
+	}
+}
+
+func TestParseError_Error(t *testing.T) {
+	_, err := NewReader(strings.NewReader("\n\ncn: Foo\n")).Read()
+	if err == nil || err.Error() != `ldif: line 3: record starts with "cn" instead of dn` {
+		t.Errorf("Read() error = %v", err)
+	}
+}
@@ -0,0 +1,175 @@
+package ldif
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"io"
+)
+
+//Writer writes records as LDIF.
+type Writer struct {
+	//Width is the maximum length of lines. Longer lines are folded. Lines are not folded if Width is less than 2.
+	Width int
+	//Version reports whether the version line is written before the first record.
+	Version bool
+
+	w       *bufio.Writer
+	records int
+}
+
+//NewWriter returns a Writer which writes to w. Lines are folded at 76 characters.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{Width: 76, w: bufio.NewWriter(w)}
+}
+
+//Write writes rec. Values which are not safe strings are base64-encoded.
+//https://tools.ietf.org/html/rfc2849#section-2
+func (w *Writer) Write(rec *Record) error {
+	if w.records > 0 {
+		if err := w.w.WriteByte('\n'); err != nil {
+			return err
+		}
+	} else if w.Version {
+		if err := w.writeLine("version: 1"); err != nil {
+			return err
+		}
+	}
+	w.records++
+	if err := w.writeAttrVal("dn", rec.DN); err != nil {
+		return err
+	}
+	if !rec.IsChange() {
+		return w.writeAttributes(rec.Attributes)
+	}
+	for _, c := range rec.Controls {
+		s := "control: " + c.OID
+		if c.Criticality {
+			s += " true"
+		}
+		if c.HasValue {
+			s += valueSpec(c.Value)
+		}
+		if err := w.writeLine(s); err != nil {
+			return err
+		}
+	}
+	if err := w.writeLine("changetype: " + rec.ChangeType); err != nil {
+		return err
+	}
+	switch rec.ChangeType {
+	case ChangeTypeAdd:
+		return w.writeAttributes(rec.Attributes)
+	case ChangeTypeDelete:
+		return nil
+	case ChangeTypeModify:
+		for _, m := range rec.Modifications {
+			if err := w.writeLine(m.Operation + ": " + m.Type); err != nil {
+				return err
+			}
+			for _, v := range m.Values {
+				if err := w.writeAttrVal(m.Type, v); err != nil {
+					return err
+				}
+			}
+			if err := w.writeLine("-"); err != nil {
+				return err
+			}
+		}
+		return nil
+	case ChangeTypeModRDN, ChangeTypeModDN:
+		if err := w.writeAttrVal("newrdn", rec.NewRDN); err != nil {
+			return err
+		}
+		deleteOldRDN := "0"
+		if rec.DeleteOldRDN {
+			deleteOldRDN = "1"
+		}
+		if err := w.writeLine("deleteoldrdn: " + deleteOldRDN); err != nil {
+			return err
+		}
+		if rec.NewSuperior != "" {
+			return w.writeAttrVal("newsuperior", rec.NewSuperior)
+		}
+		return nil
+	default:
+		return fmt.Errorf("ldif: unknown changetype %q", rec.ChangeType)
+	}
+}
+
+//WriteAll writes recs and flushes the Writer.
+func (w *Writer) WriteAll(recs []*Record) error {
+	for _, rec := range recs {
+		if err := w.Write(rec); err != nil {
+			return err
+		}
+	}
+	return w.Flush()
+}
+
+//Flush writes any buffered data to the underlying io.Writer.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+//writeAttributes writes the values of attrs.
+func (w *Writer) writeAttributes(attrs []Attribute) error {
+	for _, a := range attrs {
+		for _, v := range a.Values {
+			if err := w.writeAttrVal(a.Type, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+//writeAttrVal writes an attrval-spec.
+func (w *Writer) writeAttrVal(attr string, value string) error {
+	return w.writeLine(attr + valueSpec(value))
+}
+
+//writeLine writes s, folding it at Width.
+//s never has non-ASCII characters, because they are base64-encoded.
+func (w *Writer) writeLine(s string) error {
+	if w.Width >= 2 && len(s) > w.Width {
+		if _, err := w.w.WriteString(s[:w.Width] + "\n"); err != nil {
+			return err
+		}
+		for s = s[w.Width:]; len(s) > w.Width-1; s = s[w.Width-1:] {
+			if _, err := w.w.WriteString(" " + s[:w.Width-1] + "\n"); err != nil {
+				return err
+			}
+		}
+		s = " " + s
+	}
+	_, err := w.w.WriteString(s + "\n")
+	return err
+}
+
+//valueSpec returns the value-spec of v, which follows the attribute description.
+func valueSpec(v string) string {
+	if v == "" {
+		return ":"
+	}
+	if isSafeString(v) {
+		return ": " + v
+	}
+	return ":: " + base64.StdEncoding.EncodeToString([]byte(v))
+}
+
+//isSafeString reports whether s is a SAFE-STRING which does not end with SPACE.
+//https://tools.ietf.org/html/rfc2849#section-2
+func isSafeString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == 0X00 || c == '\n' || c == '\r' || c >= 0X80 {
+			return false
+		}
+	}
+	switch s[0] {
+	case ' ', ':', '<':
+		return false
+	}
+	return s[len(s)-1] != ' '
+}
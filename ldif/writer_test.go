@@ -0,0 +1,74 @@
+package ldif
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWriter_Write(t *testing.T) {
+	type args struct {
+		recs    []*Record
+		width   int
+		version bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{"TestCase:Content records", args{[]*Record{
+			{DN: "cn=Foo,dc=example", Attributes: []Attribute{{Type: "cn", Values: []string{"Foo", "Über", " foo", "foo ", ":foo", "<foo", ""}}}},
+			{DN: "cn=Über", Attributes: []Attribute{{Type: "cn", Values: []string{"Über"}}}},
+		}, 76, true}, "version: 1\ndn: cn=Foo,dc=example\ncn: Foo\ncn:: w5xiZXI=\ncn:: IGZvbw==\ncn:: Zm9vIA==\ncn:: OmZvbw==\ncn:: PGZvbw==\ncn:\n\ndn:: Y249w5xiZXI=\ncn:: w5xiZXI=\n", false},
+		{"TestCase:Folding", args{[]*Record{
+			{DN: "cn=Foo", Attributes: []Attribute{{Type: "description", Values: []string{"0123456789abcdef"}}}},
+		}, 10, false}, "dn: cn=Foo\ndescriptio\n n: 012345\n 6789abcde\n f\n", false},
+		{"TestCase:Change records", args{[]*Record{
+			{DN: "cn=Foo", ChangeType: ChangeTypeDelete, Controls: []Control{{OID: "1.2.3", Criticality: true, HasValue: true, Value: "value"}}},
+			{DN: "cn=Bar", ChangeType: ChangeTypeModify, Modifications: []Modification{
+				{Operation: ModReplace, Type: "sn", Values: []string{"Bar"}},
+				{Operation: ModDelete, Type: "description", Values: []string{}},
+			}},
+			{DN: "cn=Baz", ChangeType: ChangeTypeModRDN, NewRDN: "cn=Qux", DeleteOldRDN: true, NewSuperior: "dc=example"},
+		}, 76, false}, "dn: cn=Foo\ncontrol: 1.2.3 true: value\nchangetype: delete\n\ndn: cn=Bar\nchangetype: modify\nreplace: sn\nsn: Bar\n-\ndelete: description\n-\n\ndn: cn=Baz\nchangetype: modrdn\nnewrdn: cn=Qux\ndeleteoldrdn: 1\nnewsuperior: dc=example\n", false},
+		{"TestCase:Unknown changetype", args{[]*Record{{DN: "cn=Foo", ChangeType: "rename"}}, 76, false}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			w := NewWriter(&b)
+			w.Width, w.Version = tt.args.width, tt.args.version
+			err := w.WriteAll(tt.args.recs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WriteAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if b.String() != tt.want {
+				t.Errorf("WriteAll() got = %q, want %q", b.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestWriter_RoundTrip(t *testing.T) {
+	recs := []*Record{
+		{DN: "cn=Foo,dc=example", Line: 1, Controls: []Control{}, Attributes: []Attribute{{Type: "description", Values: []string{strings.Repeat("long value ", 20) + "end", "Ｆｏｏ\n"}, Lines: []int{2, 6}}}},
+		{DN: "cn=Bar", Line: 8, Controls: []Control{{OID: "1.2.3", HasValue: true, Value: "ünsafe"}}, ChangeType: ChangeTypeModify, Modifications: []Modification{{Operation: ModAdd, Type: "cn", Values: []string{"Bar"}, Lines: []int{12}}}},
+	}
+	var b strings.Builder
+	if err := NewWriter(&b).WriteAll(recs); err != nil {
+		t.Fatal(err)
+	}
+	got, err := NewReader(strings.NewReader(b.String())).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, recs) {
+		t.Errorf("ReadAll() got = %+v, want %+v\n%s", got, recs, b.String())
+	}
+}
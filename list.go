@@ -0,0 +1,102 @@
+package ldapstrprep
+
+import "strings"
+
+//PrepareList prepares each line of the Postal Address s for caseIgnoreListMatch.
+//s is split at dollar signs, \24 and \5C are unescaped, and each line is prepared as a value of caseIgnoreMatch,
+//including Insignificant Space Handling.
+//https://tools.ietf.org/html/rfc4517#section-4.2.9
+func PrepareList(s string) ([][]rune, error) {
+	lines, err := splitList(s)
+	if err != nil {
+		return nil, err
+	}
+	dst := make([][]rune, 0, len(lines))
+	for _, line := range lines {
+		r, err := prepare(line, true)
+		if err != nil {
+			return nil, err
+		}
+		dst = append(dst, ApplyInsignificantSpaceHandling(r))
+	}
+	return dst, nil
+}
+
+//MatchCaseIgnoreList reports whether attributeValue matches assertionValue by caseIgnoreListMatch.
+//Both values are Postal Addresses, and they match if they have the same number of lines
+//and the lines at the same position match by caseIgnoreMatch.
+//https://tools.ietf.org/html/rfc4517#section-4.2.9
+func MatchCaseIgnoreList(attributeValue string, assertionValue string) (bool, error) {
+	attr, err := PrepareList(attributeValue)
+	if err != nil {
+		return false, err
+	}
+	assertion, err := PrepareList(assertionValue)
+	if err != nil {
+		return false, err
+	}
+	if len(attr) != len(assertion) {
+		return false, nil
+	}
+	for i := range attr {
+		if string(attr[i]) != string(assertion[i]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+//MatchCaseIgnoreListSubstrings reports whether attributeValue matches assertionValue by caseIgnoreListSubstringsMatch.
+//attributeValue is a Postal Address and assertionValue is a Substring Assertion, such as "main st*anytown".
+//The substrings match the concatenated lines by caseIgnoreSubstringsMatch, but a substring never matches across two lines.
+//https://tools.ietf.org/html/rfc4517#section-4.2.10
+func MatchCaseIgnoreListSubstrings(attributeValue string, assertionValue string) (bool, error) {
+	attr, err := PrepareList(attributeValue)
+	if err != nil {
+		return false, err
+	}
+	a, err := ParseSubstringAssertion(assertionValue)
+	if err != nil {
+		return false, err
+	}
+	p, err := prepareSubstrings(a, true)
+	if err != nil {
+		return false, err
+	}
+	return matchSubstrings(attr, p), nil
+}
+
+//splitList splits the Postal Address s into unescaped lines.
+//https://tools.ietf.org/html/rfc4517#section-3.3.28
+func splitList(s string) ([]string, error) {
+	if err := ValidatePostalAddress(s); err != nil {
+		return nil, err
+	}
+	lines := strings.Split(s, "$")
+	for i := range lines {
+		lines[i] = unescapeHexPairs(lines[i], "24", "5C")
+	}
+	return lines, nil
+}
+
+//joinList escapes dollar signs and backslashes in lines and joins them with dollar signs.
+//https://tools.ietf.org/html/rfc4517#section-3.3.28
+func joinList(lines [][]rune) []rune {
+	dst := make([]rune, 0, 0)
+	for i, line := range lines {
+		if i != 0 {
+			dst = append(dst, '$')
+		}
+		for _, c := range line {
+			switch c {
+			case '$':
+				dst = append(dst, []rune(`\24`)...)
+			case '\\':
+				dst = append(dst, []rune(`\5C`)...)
+			default:
+				dst = append(dst, c)
+			}
+		}
+	}
+	return dst
+}
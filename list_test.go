@@ -0,0 +1,106 @@
+package ldapstrprep
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPrepareList(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    [][]rune
+		wantErr bool
+	}{
+		{"TestCase:one line", args{"1234 Main St."}, [][]rune{[]rune(" 1234  main  st. ")}, false},
+		{"TestCase:lines", args{"1234  Main St.$ Anytown, CA 12345 $USA"}, [][]rune{[]rune(" 1234  main  st. "), []rune(" anytown,  ca  12345 "), []rune(" usa ")}, false},
+		{"TestCase:escapes", args{`\241,000,000 Sweepstakes$C:\5CTemp`}, [][]rune{[]rune(" $1,000,000  sweepstakes "), []rune(` c:\temp `)}, false},
+		{"TestCase:fullwidth", args{"ＡＢＣ$ＤＥＦ"}, [][]rune{[]rune(" abc "), []rune(" def ")}, false},
+		{"TestCase:empty line", args{"a$$b"}, nil, true},
+		{"TestCase:prohibited", args{"a$\U0000E000"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PrepareList(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PrepareList() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PrepareList() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchCaseIgnoreList(t *testing.T) {
+	type args struct {
+		attributeValue string
+		assertionValue string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"TestCase:same", args{"1234 Main St.$Anytown, CA 12345$USA", "1234 Main St.$Anytown, CA 12345$USA"}, true, false},
+		{"TestCase:case and spaces", args{"1234 Main St.$Anytown, CA 12345$USA", " 1234  MAIN ST.$anytown,  ca 12345 $usa"}, true, false},
+		{"TestCase:lines differ", args{"1234 Main St.$Anytown, CA 12345$USA", "1234 Main St.$Anytown, CA 12346$USA"}, false, false},
+		{"TestCase:line boundaries differ", args{"1234 Main St.$Anytown", "1234 Main St. Anytown"}, false, false},
+		{"TestCase:number of lines differ", args{"a$b", "a$b$c"}, false, false},
+		{"TestCase:escaped dollar is not a separator", args{`a\24b`, "a$b"}, false, false},
+		{"TestCase:escaped dollar", args{`a\24b`, `A\24B`}, true, false},
+		{"TestCase:invalid attribute value", args{`a\b`, "a"}, false, true},
+		{"TestCase:invalid assertion value", args{"a", ""}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchCaseIgnoreList(tt.args.attributeValue, tt.args.assertionValue)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MatchCaseIgnoreList() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MatchCaseIgnoreList() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchCaseIgnoreListSubstrings(t *testing.T) {
+	type args struct {
+		attributeValue string
+		assertionValue string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"TestCase:initial, any and final", args{"1234 Main St.$Anytown, CA 12345$USA", "1234 main*ANYTOWN*usa"}, true, false},
+		{"TestCase:initial", args{"1234 Main St.$Anytown, CA 12345$USA", "1234  MAIN*"}, true, false},
+		{"TestCase:final", args{"1234 Main St.$Anytown, CA 12345$USA", "*Usa"}, true, false},
+		{"TestCase:final is not the last line", args{"1234 Main St.$Anytown, CA 12345$USA", "*12345"}, false, false},
+		{"TestCase:any across lines", args{"1234 Main St.$Anytown, CA 12345$USA", "*St. Anytown*USA"}, false, false},
+		{"TestCase:escaped dollar", args{`\241,000,000$Anytown`, "$*town"}, true, false},
+		{"TestCase:invalid assertion value", args{"a$b", "a"}, false, true},
+		{"TestCase:invalid attribute value", args{"a$", "a"}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchCaseIgnoreListSubstrings(tt.args.attributeValue, tt.args.assertionValue)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MatchCaseIgnoreListSubstrings() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MatchCaseIgnoreListSubstrings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package ldapstrprep
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+//mailDomainProfile converts the domains of mail addresses to A-labels by UTS #46 nontransitional processing,
+//which is compatible with IDNA2008. Upper case letters are mapped to lower case.
+//https://www.unicode.org/reports/tr46/#Processing
+var mailDomainProfile = idna.New(
+	idna.MapForLookup(),
+	idna.Transitional(false),
+	idna.BidiRule(),
+)
+
+//PrepareMail prepares s, which is an internationalized mail address, for matching.
+//s is split into the local-part and the domain at the last "@".
+//The domain is converted to A-labels and lower-cased, so the U-label and A-label forms of a domain match.
+//The local-part is prepared by the steps from Transcode to IsProhibited, and is case folded by Table B.2 if caseFolding
+//is true, as caseIgnoreMatch does. Unlike caseIgnoreIA5Match, whose values are IA5 strings, non-ASCII letters are folded,
+//because a RFC 6531 local-part is a UTF-8 string whose upper and lower case letters are not limited to ASCII.
+//The local-part is case-sensitive in RFC 5321, but most mail systems ignore its case.
+//An error is returned if s has no local-part or domain, or either of them cannot be prepared.
+//https://tools.ietf.org/html/rfc6531#section-3.3
+//https://tools.ietf.org/html/rfc5321#section-2.4
+func PrepareMail(s string, caseFolding bool) (string, error) {
+	i := strings.LastIndexByte(s, '@')
+	if i <= 0 || i == len(s)-1 {
+		return "", fmt.Errorf("ldapstrprep: invalid mail address %q: no local-part or domain", s)
+	}
+	local, err := prepare(s[:i], caseFolding)
+	if err != nil {
+		return "", err
+	}
+	domain, err := mailDomainProfile.ToASCII(s[i+1:])
+	if err != nil {
+		return "", fmt.Errorf("ldapstrprep: invalid mail address %q: %v", s, err)
+	}
+	return string(local) + "@" + domain, nil
+}
+
+//MatchMail reports whether the mail addresses a and b match once prepared by PrepareMail.
+func MatchMail(a string, b string, caseFolding bool) (bool, error) {
+	pa, err := PrepareMail(a, caseFolding)
+	if err != nil {
+		return false, err
+	}
+	pb, err := PrepareMail(b, caseFolding)
+	if err != nil {
+		return false, err
+	}
+	return pa == pb, nil
+}
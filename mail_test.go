@@ -0,0 +1,80 @@
+package ldapstrprep
+
+import (
+	"testing"
+)
+
+func TestPrepareMail(t *testing.T) {
+	type args struct {
+		s           string
+		caseFolding bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{"TestCase:ASCII", args{"User@Example.COM", false}, "User@example.com", false},
+		{"TestCase:ASCII case folding", args{"User@Example.COM", true}, "user@example.com", false},
+		{"TestCase:U-label", args{"user@b\U000000FCcher.example", false}, "user@xn--bcher-kva.example", false},
+		{"TestCase:A-label", args{"user@XN--BCHER-KVA.example", false}, "user@xn--bcher-kva.example", false},
+		{"TestCase:upper case U-label", args{"user@B\U000000DCCHER.example", false}, "user@xn--bcher-kva.example", false},
+		{"TestCase:non-ASCII local-part", args{"\U00007528\U00006237@\U00004F8B\U00005B50.\U00005E7F\U0000544A", false}, "\U00007528\U00006237@xn--fsqu00a.xn--4rr70v", false},
+		{"TestCase:non-ASCII local-part case folding", args{"J\U000000F6RG@example.com", true}, "j\U000000F6rg@example.com", false},
+		{"TestCase:non-ASCII upper case local-part", args{"\U000000DCn\U000000EFcode@example.com", false}, "\U000000DCn\U000000EFcode@example.com", false},
+		{"TestCase:non-ASCII upper case local-part case folding", args{"\U000000DCn\U000000EFcode@example.com", true}, "\U000000FCn\U000000EFcode@example.com", false},
+		{"TestCase:local-part width", args{"\U0000FF55ser@example.com", false}, "user@example.com", false},
+		{"TestCase:quoted local-part with at sign", args{"\"a@b\"@example.com", false}, "\"a@b\"@example.com", false},
+		{"TestCase:prohibited local-part", args{"a\U0000E000@example.com", false}, "", true},
+		{"TestCase:invalid domain", args{"user@xn--a.example", false}, "", true},
+		{"TestCase:no at sign", args{"user", false}, "", true},
+		{"TestCase:no local-part", args{"@example.com", false}, "", true},
+		{"TestCase:no domain", args{"user@", false}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PrepareMail(tt.args.s, tt.args.caseFolding)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PrepareMail() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("PrepareMail() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchMail(t *testing.T) {
+	type args struct {
+		a           string
+		b           string
+		caseFolding bool
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"TestCase:U-label and A-label", args{"user@b\U000000FCcher.example", "user@xn--bcher-kva.example", false}, true, false},
+		{"TestCase:domain case", args{"user@EXAMPLE.com", "user@example.com", false}, true, false},
+		{"TestCase:local-part case", args{"User@example.com", "user@example.com", false}, false, false},
+		{"TestCase:local-part case folding", args{"User@example.com", "user@example.com", true}, true, false},
+		{"TestCase:different domains", args{"user@example.com", "user@example.org", true}, false, false},
+		{"TestCase:invalid", args{"user", "user@example.com", true}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchMail(tt.args.a, tt.args.b, tt.args.caseFolding)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MatchMail() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MatchMail() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
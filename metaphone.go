@@ -0,0 +1,548 @@
+package ldapstrprep
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+//doubleMetaphoneMaxLen is the length of Double Metaphone codes.
+const doubleMetaphoneMaxLen = 4
+
+//doubleMetaphone returns the primary and alternate Double Metaphone codes of word, which is a word of Latin letters.
+//ok is false if word has other characters than Latin letters, such as digits or letters of other scripts.
+//https://en.wikipedia.org/wiki/Metaphone#Double_Metaphone
+func doubleMetaphone(word []rune) (primary string, alternate string, ok bool) {
+	value, ok := latinLetters(word)
+	if !ok || value == "" {
+		return "", "", false
+	}
+	m := &metaphone{value: value, slavoGermanic: isSlavoGermanic(value)}
+	index := 0
+	if m.contains(0, 2, "GN", "KN", "PN", "WR", "PS") {
+		index = 1
+	}
+	for !m.isComplete() && index < len(m.value) {
+		index = m.encode(index)
+	}
+	return m.primary.String(), m.alternate.String(), true
+}
+
+//latinDigraphs maps the Latin letters which do not decompose to the letters they are spelled with.
+var latinDigraphs = map[rune]string{
+	'Æ': "AE",
+	'Œ': "OE",
+	'Ø': "O",
+	'Đ': "D",
+	'Ł': "L",
+	'Þ': "TH",
+	'ß': "SS",
+}
+
+//latinLetters returns word in upper case without diacritics other than Ç and Ñ.
+//Ç and Ñ are written as their ISO 8859-1 octets, so each letter is an octet.
+//ok is false if word has other characters than Latin letters.
+func latinLetters(word []rune) (s string, ok bool) {
+	var b strings.Builder
+	for _, c := range word {
+		c = unicode.ToUpper(c)
+		if c == 'Ç' || c == 'Ñ' || (c >= 'A' && c <= 'Z') {
+			b.WriteByte(byte(c))
+			continue
+		}
+		if d, ok := latinDigraphs[c]; ok {
+			b.WriteString(d)
+			continue
+		}
+		if isCombiningMark(c) {
+			continue
+		}
+		d := []rune(norm.NFD.String(string(c)))
+		if len(d) == 0 || d[0] < 'A' || d[0] > 'Z' {
+			return "", false
+		}
+		b.WriteRune(d[0])
+	}
+	return b.String(), true
+}
+
+//isSlavoGermanic reports whether value looks like a Slavic or Germanic name.
+func isSlavoGermanic(value string) bool {
+	return strings.ContainsAny(value, "WK") || strings.Contains(value, "CZ") || strings.Contains(value, "WITZ")
+}
+
+//metaphone is the state of the encoding of a value to Double Metaphone codes.
+type metaphone struct {
+	value         string
+	slavoGermanic bool
+	primary       strings.Builder
+	alternate     strings.Builder
+}
+
+//isComplete reports whether both codes are complete.
+func (m *metaphone) isComplete() bool {
+	return m.primary.Len() >= doubleMetaphoneMaxLen && m.alternate.Len() >= doubleMetaphoneMaxLen
+}
+
+//appendPrimary appends s to the primary code, within its length.
+func (m *metaphone) appendPrimary(s string) {
+	if n := doubleMetaphoneMaxLen - m.primary.Len(); n < len(s) {
+		s = s[:max(n, 0)]
+	}
+	m.primary.WriteString(s)
+}
+
+//appendAlternate appends s to the alternate code, within its length.
+func (m *metaphone) appendAlternate(s string) {
+	if n := doubleMetaphoneMaxLen - m.alternate.Len(); n < len(s) {
+		s = s[:max(n, 0)]
+	}
+	m.alternate.WriteString(s)
+}
+
+//append appends primary and alternate to the codes.
+func (m *metaphone) append(primary string, alternate string) {
+	m.appendPrimary(primary)
+	m.appendAlternate(alternate)
+}
+
+//at returns the letter at index of the value, or 0 if index is out of range.
+func (m *metaphone) at(index int) rune {
+	if index < 0 || index >= len(m.value) {
+		return 0
+	}
+	return rune(m.value[index])
+}
+
+//contains reports whether the value has one of the criteria of length n at start.
+func (m *metaphone) contains(start int, n int, criteria ...string) bool {
+	if start < 0 || start+n > len(m.value) {
+		return false
+	}
+	for _, c := range criteria {
+		if m.value[start:start+n] == c {
+			return true
+		}
+	}
+	return false
+}
+
+//isVowel reports whether c is a vowel.
+func isVowel(c rune) bool {
+	return strings.ContainsRune("AEIOUY", c)
+}
+
+//skip returns the index after the letter at index, which is doubled if the next letter is one of next.
+func (m *metaphone) skip(index int, next string) int {
+	if c := m.at(index + 1); c != 0 && strings.ContainsRune(next, c) {
+		return index + 2
+	}
+	return index + 1
+}
+
+//encode encodes the letters at index, and returns the index of the next letters.
+func (m *metaphone) encode(index int) int {
+	switch c := m.at(index); c {
+	case 'A', 'E', 'I', 'O', 'U', 'Y':
+		if index == 0 {
+			m.append("A", "A")
+		}
+		return index + 1
+	case 'B':
+		m.append("P", "P")
+		return m.skip(index, "B")
+	case 'Ç':
+		m.append("S", "S")
+		return index + 1
+	case 'C':
+		return m.encodeC(index)
+	case 'D':
+		return m.encodeD(index)
+	case 'F':
+		m.append("F", "F")
+		return m.skip(index, "F")
+	case 'G':
+		return m.encodeG(index)
+	case 'H':
+		if (index == 0 || isVowel(m.at(index-1))) && isVowel(m.at(index+1)) {
+			m.append("H", "H")
+			return index + 2
+		}
+		return index + 1
+	case 'J':
+		return m.encodeJ(index)
+	case 'K':
+		m.append("K", "K")
+		return m.skip(index, "K")
+	case 'L':
+		return m.encodeL(index)
+	case 'M':
+		m.append("M", "M")
+		if m.at(index+1) == 'M' || (m.contains(index-1, 3, "UMB") && (index+1 == len(m.value)-1 || m.contains(index+2, 2, "ER"))) {
+			return index + 2
+		}
+		return index + 1
+	case 'N':
+		m.append("N", "N")
+		return m.skip(index, "N")
+	case 'Ñ':
+		m.append("N", "N")
+		return index + 1
+	case 'P':
+		if m.at(index+1) == 'H' {
+			m.append("F", "F")
+			return index + 2
+		}
+		m.append("P", "P")
+		return m.skip(index, "PB")
+	case 'Q':
+		m.append("K", "K")
+		return m.skip(index, "Q")
+	case 'R':
+		if index == len(m.value)-1 && !m.slavoGermanic && m.contains(index-2, 2, "IE") && !m.contains(index-4, 2, "ME", "MA") {
+			m.appendAlternate("R")
+		} else {
+			m.append("R", "R")
+		}
+		return m.skip(index, "R")
+	case 'S':
+		return m.encodeS(index)
+	case 'T':
+		return m.encodeT(index)
+	case 'V':
+		m.append("F", "F")
+		return m.skip(index, "V")
+	case 'W':
+		return m.encodeW(index)
+	case 'X':
+		if index == 0 {
+			m.append("S", "S")
+			return index + 1
+		}
+		if !(index == len(m.value)-1 && (m.contains(index-3, 3, "IAU", "EAU") || m.contains(index-2, 2, "AU", "OU"))) {
+			m.append("KS", "KS")
+		}
+		return m.skip(index, "CX")
+	case 'Z':
+		if m.at(index+1) == 'H' {
+			m.append("J", "J")
+			return index + 2
+		}
+		if m.contains(index+1, 2, "ZO", "ZI", "ZA") || (m.slavoGermanic && index > 0 && m.at(index-1) != 'T') {
+			m.append("S", "TS")
+		} else {
+			m.append("S", "S")
+		}
+		return m.skip(index, "Z")
+	default:
+		return index + 1
+	}
+}
+
+func (m *metaphone) encodeC(index int) int {
+	switch {
+	case m.isGermanicC(index):
+		m.append("K", "K")
+		return index + 2
+	case index == 0 && m.contains(index, 6, "CAESAR"):
+		m.append("S", "S")
+		return index + 2
+	case m.contains(index, 2, "CH"):
+		return m.encodeCH(index)
+	case m.contains(index, 2, "CZ") && !m.contains(index-2, 4, "WICZ"):
+		m.append("S", "X")
+		return index + 2
+	case m.contains(index+1, 3, "CIA"):
+		m.append("X", "X")
+		return index + 3
+	case m.contains(index, 2, "CC") && !(index == 1 && m.at(0) == 'M'):
+		if m.contains(index+2, 1, "I", "E", "H") && !m.contains(index+2, 2, "HU") {
+			if (index == 1 && m.at(index-1) == 'A') || m.contains(index-1, 5, "UCCEE", "UCCES") {
+				m.append("KS", "KS")
+			} else {
+				m.append("X", "X")
+			}
+			return index + 3
+		}
+		m.append("K", "K")
+		return index + 2
+	case m.contains(index, 2, "CK", "CG", "CQ"):
+		m.append("K", "K")
+		return index + 2
+	case m.contains(index, 2, "CI", "CE", "CY"):
+		if m.contains(index, 3, "CIO", "CIE", "CIA") {
+			m.append("S", "X")
+		} else {
+			m.append("S", "S")
+		}
+		return index + 2
+	default:
+		m.append("K", "K")
+		if m.contains(index+1, 1, "C", "K", "Q") && !m.contains(index+1, 2, "CE", "CI") {
+			return index + 2
+		}
+		return index + 1
+	}
+}
+
+//isGermanicC reports whether the C at index is Germanic, such as "bacher".
+func (m *metaphone) isGermanicC(index int) bool {
+	switch {
+	case m.contains(index, 4, "CHIA"):
+		return true
+	case index <= 1, isVowel(m.at(index - 2)), !m.contains(index-1, 3, "ACH"):
+		return false
+	default:
+		c := m.at(index + 2)
+		return (c != 'I' && c != 'E') || m.contains(index-2, 6, "BACHER", "MACHER")
+	}
+}
+
+func (m *metaphone) encodeCH(index int) int {
+	switch {
+	case index > 0 && m.contains(index, 4, "CHAE"):
+		m.append("K", "X")
+	case index == 0 && (m.contains(index+1, 5, "HARAC", "HARIS") || m.contains(index+1, 3, "HOR", "HYM", "HIA", "HEM")) && !m.contains(0, 5, "CHORE"):
+		m.append("K", "K")
+	case m.contains(0, 4, "VAN ", "VON ") || m.contains(0, 3, "SCH") ||
+		m.contains(index-2, 6, "ORCHES", "ARCHIT", "ORCHID") ||
+		m.contains(index+2, 1, "T", "S") ||
+		((m.contains(index-1, 1, "A", "O", "U", "E") || index == 0) &&
+			(m.contains(index+2, 1, "L", "R", "N", "M", "B", "H", "F", "V", "W", " ") || index+1 == len(m.value)-1)):
+		m.append("K", "K")
+	case index > 0 && m.contains(0, 2, "MC"):
+		m.append("K", "K")
+	case index > 0:
+		m.append("X", "K")
+	default:
+		m.append("X", "X")
+	}
+	return index + 2
+}
+
+func (m *metaphone) encodeD(index int) int {
+	switch {
+	case m.contains(index, 2, "DG"):
+		if m.contains(index+2, 1, "I", "E", "Y") {
+			m.append("J", "J")
+			return index + 3
+		}
+		m.append("TK", "TK")
+		return index + 2
+	case m.contains(index, 2, "DT", "DD"):
+		m.append("T", "T")
+		return index + 2
+	default:
+		m.append("T", "T")
+		return index + 1
+	}
+}
+
+func (m *metaphone) encodeG(index int) int {
+	switch {
+	case m.at(index+1) == 'H':
+		return m.encodeGH(index)
+	case m.at(index+1) == 'N':
+		switch {
+		case index == 1 && isVowel(m.at(0)) && !m.slavoGermanic:
+			m.append("KN", "N")
+		case !m.contains(index+2, 2, "EY") && m.at(index+1) != 'Y' && !m.slavoGermanic:
+			m.append("N", "KN")
+		default:
+			m.append("KN", "KN")
+		}
+		return index + 2
+	case m.contains(index+1, 2, "LI") && !m.slavoGermanic:
+		m.append("KL", "L")
+		return index + 2
+	case index == 0 && (m.at(index+1) == 'Y' || m.contains(index+1, 2, "ES", "EP", "EB", "EL", "EY", "IB", "IL", "IN", "IE", "EI", "ER")):
+		m.append("K", "J")
+		return index + 2
+	case (m.contains(index+1, 2, "ER") || m.at(index+1) == 'Y') &&
+		!m.contains(0, 6, "DANGER", "RANGER", "MANGER") &&
+		!m.contains(index-1, 1, "E", "I") &&
+		!m.contains(index-1, 3, "RGY", "OGY"):
+		m.append("K", "J")
+		return index + 2
+	case m.contains(index+1, 1, "E", "I", "Y") || m.contains(index-1, 4, "AGGI", "OGGI"):
+		switch {
+		case m.contains(0, 4, "VAN ", "VON ") || m.contains(0, 3, "SCH") || m.contains(index+1, 2, "ET"):
+			m.append("K", "K")
+		case m.contains(index+1, 3, "IER"):
+			m.append("J", "J")
+		default:
+			m.append("J", "K")
+		}
+		return index + 2
+	case m.at(index+1) == 'G':
+		m.append("K", "K")
+		return index + 2
+	default:
+		m.append("K", "K")
+		return index + 1
+	}
+}
+
+func (m *metaphone) encodeGH(index int) int {
+	switch {
+	case index > 0 && !isVowel(m.at(index-1)):
+		m.append("K", "K")
+	case index == 0:
+		if m.at(index+2) == 'I' {
+			m.append("J", "J")
+		} else {
+			m.append("K", "K")
+		}
+	case (index > 1 && m.contains(index-2, 1, "B", "H", "D")) ||
+		(index > 2 && m.contains(index-3, 1, "B", "H", "D")) ||
+		(index > 3 && m.contains(index-4, 1, "B", "H")):
+		//silent, such as "bough"
+	case index > 2 && m.at(index-1) == 'U' && m.contains(index-3, 1, "C", "G", "L", "R", "T"):
+		m.append("F", "F")
+	case index > 0 && m.at(index-1) != 'I':
+		m.append("K", "K")
+	}
+	return index + 2
+}
+
+func (m *metaphone) encodeJ(index int) int {
+	if m.contains(index, 4, "JOSE") || m.contains(0, 4, "SAN ") {
+		if (index == 0 && m.at(index+4) == ' ') || len(m.value) == 4 || m.contains(0, 4, "SAN ") {
+			m.append("H", "H")
+		} else {
+			m.append("J", "H")
+		}
+		return index + 1
+	}
+	switch {
+	case index == 0:
+		m.append("J", "A")
+	case isVowel(m.at(index-1)) && !m.slavoGermanic && (m.at(index+1) == 'A' || m.at(index+1) == 'O'):
+		m.append("J", "H")
+	case index == len(m.value)-1:
+		m.append("J", " ")
+	case !m.contains(index+1, 1, "L", "T", "K", "S", "N", "M", "B", "Z") && !m.contains(index-1, 1, "S", "K", "L"):
+		m.append("J", "J")
+	}
+	return m.skip(index, "J")
+}
+
+func (m *metaphone) encodeL(index int) int {
+	if m.at(index+1) != 'L' {
+		m.append("L", "L")
+		return index + 1
+	}
+	n := len(m.value)
+	if (index == n-3 && m.contains(index-1, 4, "ILLO", "ILLA", "ALLE")) ||
+		((m.contains(n-2, 2, "AS", "OS") || m.contains(n-1, 1, "A", "O")) && m.contains(index-1, 4, "ALLE")) {
+		//Spanish, such as "cabrillo"
+		m.appendPrimary("L")
+	} else {
+		m.append("L", "L")
+	}
+	return index + 2
+}
+
+func (m *metaphone) encodeS(index int) int {
+	switch {
+	case m.contains(index-1, 3, "ISL", "YSL"):
+		//silent, such as "island"
+		return index + 1
+	case index == 0 && m.contains(index, 5, "SUGAR"):
+		m.append("X", "S")
+		return index + 1
+	case m.contains(index, 2, "SH"):
+		if m.contains(index+1, 4, "HEIM", "HOEK", "HOLM", "HOLZ") {
+			m.append("S", "S")
+		} else {
+			m.append("X", "X")
+		}
+		return index + 2
+	case m.contains(index, 3, "SIO", "SIA") || m.contains(index, 4, "SIAN"):
+		if m.slavoGermanic {
+			m.append("S", "S")
+		} else {
+			m.append("S", "X")
+		}
+		return index + 3
+	case (index == 0 && m.contains(index+1, 1, "M", "N", "L", "W")) || m.contains(index+1, 1, "Z"):
+		m.append("S", "X")
+		return m.skip(index, "Z")
+	case m.contains(index, 2, "SC"):
+		return m.encodeSC(index)
+	default:
+		if index == len(m.value)-1 && m.contains(index-2, 2, "AI", "OI") {
+			//French, such as "resnais"
+			m.appendAlternate("S")
+		} else {
+			m.append("S", "S")
+		}
+		return m.skip(index, "SZ")
+	}
+}
+
+func (m *metaphone) encodeSC(index int) int {
+	switch {
+	case m.at(index+2) == 'H':
+		switch {
+		case m.contains(index+3, 2, "ER", "EN"):
+			m.append("X", "SK")
+		case m.contains(index+3, 2, "OO", "UY", "ED", "EM"):
+			m.append("SK", "SK")
+		case index == 0 && !isVowel(m.at(3)) && m.at(3) != 'W':
+			m.append("X", "S")
+		default:
+			m.append("X", "X")
+		}
+	case m.contains(index+2, 1, "I", "E", "Y"):
+		m.append("S", "S")
+	default:
+		m.append("SK", "SK")
+	}
+	return index + 3
+}
+
+func (m *metaphone) encodeT(index int) int {
+	switch {
+	case m.contains(index, 4, "TION"), m.contains(index, 3, "TIA", "TCH"):
+		m.append("X", "X")
+		return index + 3
+	case m.contains(index, 2, "TH") || m.contains(index, 3, "TTH"):
+		if m.contains(index+2, 2, "OM", "AM") || m.contains(0, 4, "VAN ", "VON ") || m.contains(0, 3, "SCH") {
+			m.append("T", "T")
+		} else {
+			m.append("0", "T")
+		}
+		return index + 2
+	default:
+		m.append("T", "T")
+		return m.skip(index, "TD")
+	}
+}
+
+func (m *metaphone) encodeW(index int) int {
+	switch {
+	case m.contains(index, 2, "WR"):
+		m.append("R", "R")
+		return index + 2
+	case index == 0 && (isVowel(m.at(index+1)) || m.contains(index, 2, "WH")):
+		if isVowel(m.at(index + 1)) {
+			m.append("A", "F")
+		} else {
+			m.append("A", "A")
+		}
+		return index + 1
+	case (index == len(m.value)-1 && isVowel(m.at(index-1))) ||
+		m.contains(index-1, 5, "EWSKI", "EWSKY", "OWSKI", "OWSKY") ||
+		m.contains(0, 3, "SCH"):
+		//Polish, such as "filipowicz"
+		m.appendAlternate("F")
+		return index + 1
+	case m.contains(index, 4, "WICZ", "WITZ"):
+		m.append("TS", "FX")
+		return index + 4
+	default:
+		return index + 1
+	}
+}
@@ -0,0 +1,53 @@
+package ldapstrprep
+
+import (
+	"testing"
+)
+
+func Test_doubleMetaphone(t *testing.T) {
+	type args struct {
+		word string
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantPrimary   string
+		wantAlternate string
+		wantOk        bool
+	}{
+		{"TestCase:smith", args{"smith"}, "SM0", "XMT", true},
+		{"TestCase:schmidt", args{"schmidt"}, "XMT", "SMT", true},
+		{"TestCase:thomas", args{"thomas"}, "TMS", "TMS", true},
+		{"TestCase:jose", args{"jose"}, "HS", "HS", true},
+		{"TestCase:caesar", args{"caesar"}, "SSR", "SSR", true},
+		{"TestCase:silent start", args{"knight"}, "NT", "NT", true},
+		{"TestCase:initial X", args{"xavier"}, "SF", "SFR", true},
+		{"TestCase:GH", args{"gough"}, "KF", "KF", true},
+		{"TestCase:PH", args{"philip"}, "FLP", "FLP", true},
+		{"TestCase:Germanic CH", args{"bacher"}, "PKR", "PKR", true},
+		{"TestCase:Greek CH", args{"chorus"}, "KRS", "KRS", true},
+		{"TestCase:TH", args{"catherine"}, "K0RN", "KTRN", true},
+		{"TestCase:initial J", args{"john"}, "JN", "AN", true},
+		{"TestCase:SUGAR", args{"sugar"}, "XKR", "SKR", true},
+		{"TestCase:silent S", args{"island"}, "ALNT", "ALNT", true},
+		{"TestCase:Spanish LL", args{"cabrillo"}, "KPRL", "KPR", true},
+		{"TestCase:Polish W", args{"filipowicz"}, "FLPT", "FLPF", true},
+		{"TestCase:diaeresis", args{"m\U000000FCller"}, "MLR", "MLR", true},
+		{"TestCase:cedilla", args{"gar\U000000E7on"}, "KRSN", "KRSN", true},
+		{"TestCase:tilde", args{"pe\U000000F1a"}, "PN", "PN", true},
+		{"TestCase:combining mark", args{"mu\U00000308ller"}, "MLR", "MLR", true},
+		{"TestCase:ligature", args{"\U000000E6sop"}, "ASP", "ASP", true},
+		{"TestCase:code length", args{"thompson"}, "TMPS", "TMPS", true},
+		{"TestCase:digits", args{"123"}, "", "", false},
+		{"TestCase:other script", args{"\U00005C71\U00007530"}, "", "", false},
+		{"TestCase:blank", args{""}, "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotPrimary, gotAlternate, gotOk := doubleMetaphone([]rune(tt.args.word))
+			if gotPrimary != tt.wantPrimary || gotAlternate != tt.wantAlternate || gotOk != tt.wantOk {
+				t.Errorf("doubleMetaphone() = %q, %q, %v, want %q, %q, %v", gotPrimary, gotAlternate, gotOk, tt.wantPrimary, tt.wantAlternate, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package ldapstrprep
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+)
+
+//oidNameConstraints is the object identifier of the name constraints extension.
+var oidNameConstraints = asn1.ObjectIdentifier{2, 5, 29, 30}
+
+//tagDirectoryName is the context-specific tag of directoryName in GeneralName.
+const tagDirectoryName = 4
+
+//nameConstraints is the NameConstraints extension.
+//https://tools.ietf.org/html/rfc5280#section-4.2.1.10
+type nameConstraints struct {
+	Permitted []generalSubtree `asn1:"optional,tag:0"`
+	Excluded  []generalSubtree `asn1:"optional,tag:1"`
+}
+
+//generalSubtree is a GeneralSubtree of the NameConstraints extension.
+//Maximum is kept raw, since it has no default value and any present maximum is invalid.
+type generalSubtree struct {
+	Base    asn1.RawValue
+	Minimum int           `asn1:"optional,tag:0,default:0"`
+	Maximum asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+//ParseDirectoryNameConstraints parses ext, which is the value of the name constraints extension (2.5.29.30),
+//and returns the prepared bases of the permitted and excluded directoryName subtrees.
+//Subtrees of the other name forms are ignored. crypto/x509 does not parse directoryName subtrees,
+//so ext is usually found in the Extensions of x509.Certificate.
+//An error is returned if a subtree has a minimum other than zero or a maximum, which RFC 5280 forbids.
+//https://tools.ietf.org/html/rfc5280#section-4.2.1.10
+func ParseDirectoryNameConstraints(ext []byte) (permitted []PreparedName, excluded []PreparedName, err error) {
+	var nc nameConstraints
+	rest, err := asn1.Unmarshal(ext, &nc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("ldapstrprep: invalid NameConstraints: %v", err)
+	}
+	if len(rest) != 0 {
+		return nil, nil, fmt.Errorf("ldapstrprep: invalid NameConstraints: trailing data")
+	}
+	if permitted, err = prepareDirectoryNameSubtrees(nc.Permitted); err != nil {
+		return nil, nil, err
+	}
+	if excluded, err = prepareDirectoryNameSubtrees(nc.Excluded); err != nil {
+		return nil, nil, err
+	}
+	return permitted, excluded, nil
+}
+
+//CertificateDirectoryNameConstraints returns the prepared bases of the permitted and excluded directoryName subtrees
+//of the name constraints extension of ca. Both are empty if ca has no name constraints extension.
+func CertificateDirectoryNameConstraints(ca *x509.Certificate) (permitted []PreparedName, excluded []PreparedName, err error) {
+	for _, ext := range ca.Extensions {
+		if ext.Id.Equal(oidNameConstraints) {
+			return ParseDirectoryNameConstraints(ext.Value)
+		}
+	}
+	return make([]PreparedName, 0, 0), make([]PreparedName, 0, 0), nil
+}
+
+//WithinDirectoryNameSubtrees reports whether subject is acceptable under the directoryName subtrees permitted and excluded.
+//subject is within a subtree if the base of the subtree is a prefix of subject, comparing the relative distinguished
+//names one by one. subject is not acceptable if it is within any excluded subtree, or if permitted is not empty
+//and subject is not within any permitted subtree. An empty subject is always acceptable.
+//https://tools.ietf.org/html/rfc5280#section-4.2.1.10
+func WithinDirectoryNameSubtrees(subject PreparedName, permitted []PreparedName, excluded []PreparedName) bool {
+	if len(subject) == 0 {
+		return true
+	}
+	for _, base := range excluded {
+		if subject.HasPrefix(base) {
+			return false
+		}
+	}
+	if len(permitted) == 0 {
+		return true
+	}
+	for _, base := range permitted {
+		if subject.HasPrefix(base) {
+			return true
+		}
+	}
+	return false
+}
+
+//HasPrefix reports whether the relative distinguished names of n begin with the ones of base.
+func (n PreparedName) HasPrefix(base PreparedName) bool {
+	return len(n) >= len(base) && n[:len(base)].Equal(base)
+}
+
+//prepareDirectoryNameSubtrees prepares the bases of the directoryName subtrees of subtrees.
+func prepareDirectoryNameSubtrees(subtrees []generalSubtree) ([]PreparedName, error) {
+	dst := make([]PreparedName, 0, 0)
+	for _, st := range subtrees {
+		if st.Base.Class != asn1.ClassContextSpecific || st.Base.Tag != tagDirectoryName {
+			continue
+		}
+		if st.Minimum != 0 || len(st.Maximum.FullBytes) != 0 {
+			return nil, fmt.Errorf("ldapstrprep: invalid NameConstraints: minimum and maximum of a subtree must be absent")
+		}
+		base, err := PrepareRawName(st.Base.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		dst = append(dst, base)
+	}
+	return dst, nil
+}
@@ -0,0 +1,164 @@
+package ldapstrprep
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"testing"
+)
+
+//marshalNameConstraints returns the value of the name constraints extension whose subtrees have the bases.
+func marshalNameConstraints(permitted []asn1.RawValue, excluded []asn1.RawValue) []byte {
+	nc := nameConstraints{}
+	for _, b := range permitted {
+		nc.Permitted = append(nc.Permitted, generalSubtree{Base: b})
+	}
+	for _, b := range excluded {
+		nc.Excluded = append(nc.Excluded, generalSubtree{Base: b})
+	}
+	der, err := asn1.Marshal(nc)
+	if err != nil {
+		panic(err)
+	}
+	return der
+}
+
+//marshalMaximumNameConstraints returns the value of the name constraints extension
+//whose permitted subtree has base and the DER encoded INTEGER maximum.
+func marshalMaximumNameConstraints(base asn1.RawValue, maximum []byte) []byte {
+	st := generalSubtree{Base: base, Maximum: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 1, Bytes: maximum}}
+	der, err := asn1.Marshal(nameConstraints{Permitted: []generalSubtree{st}})
+	if err != nil {
+		panic(err)
+	}
+	return der
+}
+
+//directoryName returns the GeneralName of the directoryName seq.
+func directoryName(seq pkix.RDNSequence) asn1.RawValue {
+	der, err := asn1.Marshal(seq)
+	if err != nil {
+		panic(err)
+	}
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: tagDirectoryName, IsCompound: true, Bytes: der}
+}
+
+//mustPrepareRDNSequence prepares seq or fails the test.
+func mustPrepareRDNSequence(t *testing.T, seq pkix.RDNSequence) PreparedName {
+	t.Helper()
+	n, err := PrepareRDNSequence(seq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestParseDirectoryNameConstraints(t *testing.T) {
+	jp := pkix.RDNSequence{{{Type: oidCountry, Value: "JP"}}}
+	example := pkix.RDNSequence{{{Type: oidCountry, Value: "JP"}}, {{Type: oidOrganization, Value: "Example"}}}
+	dnsName := asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 2, Bytes: []byte("example.com")}
+	type args struct {
+		ext []byte
+	}
+	tests := []struct {
+		name          string
+		args          args
+		wantPermitted []pkix.RDNSequence
+		wantExcluded  []pkix.RDNSequence
+		wantErr       bool
+	}{
+		{"TestCase:Permitted and excluded", args{marshalNameConstraints([]asn1.RawValue{directoryName(jp)}, []asn1.RawValue{directoryName(example)})}, []pkix.RDNSequence{jp}, []pkix.RDNSequence{example}, false},
+		{"TestCase:Other name forms are ignored", args{marshalNameConstraints([]asn1.RawValue{dnsName, directoryName(jp)}, nil)}, []pkix.RDNSequence{jp}, []pkix.RDNSequence{}, false},
+		{"TestCase:Maximum is present", args{marshalMaximumNameConstraints(directoryName(jp), []byte{0X01})}, nil, nil, true},
+		{"TestCase:Maximum of -1 is present", args{marshalMaximumNameConstraints(directoryName(jp), []byte{0XFF})}, nil, nil, true},
+		{"TestCase:Not NameConstraints", args{[]byte{0X04, 0X00}}, nil, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permitted, excluded, err := ParseDirectoryNameConstraints(tt.args.ext)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDirectoryNameConstraints() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			equal := func(got []PreparedName, want []pkix.RDNSequence) bool {
+				if len(got) != len(want) {
+					return false
+				}
+				for i := range got {
+					if !got[i].Equal(mustPrepareRDNSequence(t, want[i])) {
+						return false
+					}
+				}
+				return true
+			}
+			if !equal(permitted, tt.wantPermitted) {
+				t.Errorf("ParseDirectoryNameConstraints() permitted = %v, want %v", permitted, tt.wantPermitted)
+			}
+			if !equal(excluded, tt.wantExcluded) {
+				t.Errorf("ParseDirectoryNameConstraints() excluded = %v, want %v", excluded, tt.wantExcluded)
+			}
+		})
+	}
+}
+
+func TestCertificateDirectoryNameConstraints(t *testing.T) {
+	jp := pkix.RDNSequence{{{Type: oidCountry, Value: "JP"}}}
+	ca := &x509.Certificate{Extensions: []pkix.Extension{
+		{Id: asn1.ObjectIdentifier{2, 5, 29, 19}, Value: []byte{0X30, 0X00}},
+		{Id: oidNameConstraints, Critical: true, Value: marshalNameConstraints([]asn1.RawValue{directoryName(jp)}, nil)},
+	}}
+	permitted, excluded, err := CertificateDirectoryNameConstraints(ca)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(permitted) != 1 || !permitted[0].Equal(mustPrepareRDNSequence(t, jp)) || len(excluded) != 0 {
+		t.Errorf("CertificateDirectoryNameConstraints() = %v, %v", permitted, excluded)
+	}
+	permitted, excluded, err = CertificateDirectoryNameConstraints(&x509.Certificate{})
+	if err != nil || len(permitted) != 0 || len(excluded) != 0 {
+		t.Errorf("CertificateDirectoryNameConstraints() = %v, %v, %v", permitted, excluded, err)
+	}
+}
+
+func TestWithinDirectoryNameSubtrees(t *testing.T) {
+	jp := pkix.RDNSequence{{{Type: oidCountry, Value: "JP"}}}
+	example := pkix.RDNSequence{{{Type: oidCountry, Value: "JP"}}, {{Type: oidOrganization, Value: "Example  Inc."}}}
+	sales := pkix.RDNSequence{{{Type: oidCountry, Value: "JP"}}, {{Type: oidOrganization, Value: "Example  Inc."}}, {{Type: oidOrganizationalUnit, Value: "Sales"}}}
+	type args struct {
+		subject   pkix.RDNSequence
+		permitted []pkix.RDNSequence
+		excluded  []pkix.RDNSequence
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"TestCase:No constraints", args{example, nil, nil}, true},
+		{"TestCase:Within a permitted subtree", args{pkix.RDNSequence{{{Type: oidCountry, Value: "jp"}}, {{Type: oidOrganization, Value: " EXAMPLE INC. "}}, {{Type: oidCommonName, Value: "Foo"}}}, []pkix.RDNSequence{example}, nil}, true},
+		{"TestCase:Equal to a permitted base", args{example, []pkix.RDNSequence{example}, nil}, true},
+		{"TestCase:Not within any permitted subtree", args{pkix.RDNSequence{{{Type: oidCountry, Value: "JP"}}, {{Type: oidOrganization, Value: "Other"}}}, []pkix.RDNSequence{example}, nil}, false},
+		{"TestCase:Shorter than a permitted base", args{jp, []pkix.RDNSequence{example}, nil}, false},
+		{"TestCase:Within an excluded subtree", args{pkix.RDNSequence{sales[0], sales[1], sales[2], {{Type: oidCommonName, Value: "Foo"}}}, []pkix.RDNSequence{jp}, []pkix.RDNSequence{sales}}, false},
+		{"TestCase:Excluded takes precedence", args{example, []pkix.RDNSequence{example}, []pkix.RDNSequence{jp}}, false},
+		{"TestCase:Empty subject", args{pkix.RDNSequence{}, []pkix.RDNSequence{example}, nil}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permitted := make([]PreparedName, 0, 0)
+			for _, seq := range tt.args.permitted {
+				permitted = append(permitted, mustPrepareRDNSequence(t, seq))
+			}
+			excluded := make([]PreparedName, 0, 0)
+			for _, seq := range tt.args.excluded {
+				excluded = append(excluded, mustPrepareRDNSequence(t, seq))
+			}
+			if got := WithinDirectoryNameSubtrees(mustPrepareRDNSequence(t, tt.args.subject), permitted, excluded); got != tt.want {
+				t.Errorf("WithinDirectoryNameSubtrees() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
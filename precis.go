@@ -0,0 +1,95 @@
+package ldapstrprep
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/secure/precis"
+)
+
+//Names of the PRECIS string classes and profiles which are enforced by PreparePRECIS.
+//https://tools.ietf.org/html/rfc8264#section-4
+//https://tools.ietf.org/html/rfc8265#section-3
+//https://tools.ietf.org/html/rfc8265#section-4
+const (
+	PRECISIdentifierClass       = "IdentifierClass"
+	PRECISFreeformClass         = "FreeformClass"
+	PRECISUsernameCaseMapped    = "UsernameCaseMapped"
+	PRECISUsernameCasePreserved = "UsernameCasePreserved"
+	PRECISOpaqueString          = "OpaqueString"
+)
+
+//precisProfiles maps the lower-cased names of PRECIS string classes and profiles to them.
+//The string classes are enforced without additional rules.
+var precisProfiles = map[string]*precis.Profile{
+	strings.ToLower(PRECISIdentifierClass):       precis.NewIdentifier(),
+	strings.ToLower(PRECISFreeformClass):         precis.NewFreeform(),
+	strings.ToLower(PRECISUsernameCaseMapped):    precis.UsernameCaseMapped,
+	strings.ToLower(PRECISUsernameCasePreserved): precis.UsernameCasePreserved,
+	strings.ToLower(PRECISOpaqueString):          precis.OpaqueString,
+}
+
+//PreparePRECIS enforces the PRECIS string class or profile named profile, such as "UsernameCaseMapped", on s.
+//Values are stored in the returned form, as RFC 4518 prepared values are for the matching rules.
+//An error is returned if profile is unknown, or s is not allowed by it.
+//https://tools.ietf.org/html/rfc8264#section-7
+func PreparePRECIS(profile string, s string) (string, error) {
+	p, ok := precisProfiles[strings.ToLower(profile)]
+	if !ok {
+		return "", fmt.Errorf("ldapstrprep: unknown PRECIS profile %q", profile)
+	}
+	dst, err := p.String(s)
+	if err != nil {
+		return "", fmt.Errorf("ldapstrprep: %s: %q: %v", profile, s, err)
+	}
+	return dst, nil
+}
+
+//PRECISDifference is a value whose caseIgnoreMatch form differs from its UsernameCaseMapped form.
+type PRECISDifference struct {
+	Value string
+	//CaseIgnore is the value prepared for caseIgnoreMatch, whose words are joined with single spaces.
+	CaseIgnore    string
+	CaseIgnoreErr error
+	//UsernameCaseMapped is the value enforced by the UsernameCaseMapped profile.
+	UsernameCaseMapped    string
+	UsernameCaseMappedErr error
+}
+
+//Reason returns the reason why the forms of d differ.
+func (d *PRECISDifference) Reason() string {
+	switch {
+	case d.CaseIgnoreErr != nil && d.UsernameCaseMappedErr != nil:
+		return "rejected by both"
+	case d.CaseIgnoreErr != nil:
+		return "rejected by " + CaseIgnoreMatch
+	case d.UsernameCaseMappedErr != nil:
+		return "rejected by " + PRECISUsernameCaseMapped
+	default:
+		return "different forms"
+	}
+}
+
+//ComparePRECIS compares the caseIgnoreMatch form and the UsernameCaseMapped form of values, and returns the differences
+//in the order of values. A value differs if it is rejected by either of them, or the forms are different,
+//such as "ß", which is case folded to "ss" by Table B.2 of RFC 3454 but kept by UsernameCaseMapped.
+//The insignificant spaces of the caseIgnoreMatch form are not compared.
+//https://tools.ietf.org/html/rfc8265#section-3.3
+func ComparePRECIS(values []string) []PRECISDifference {
+	dst := make([]PRECISDifference, 0, 0)
+	for _, v := range values {
+		d := PRECISDifference{Value: v}
+		p, err := Prepare(CaseIgnoreMatch, v)
+		if err != nil {
+			d.CaseIgnoreErr = err
+		} else {
+			d.CaseIgnore = joinWords(p)
+		}
+		d.UsernameCaseMapped, d.UsernameCaseMappedErr = PreparePRECIS(PRECISUsernameCaseMapped, v)
+		if d.CaseIgnoreErr == nil && d.UsernameCaseMappedErr == nil && d.CaseIgnore == d.UsernameCaseMapped {
+			continue
+		}
+		dst = append(dst, d)
+	}
+	return dst
+}
@@ -0,0 +1,86 @@
+package ldapstrprep
+
+import (
+	"testing"
+)
+
+func TestPreparePRECIS(t *testing.T) {
+	type args struct {
+		profile string
+		s       string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{"TestCase:UsernameCaseMapped", args{PRECISUsernameCaseMapped, "ABC"}, "abc", false},
+		{"TestCase:UsernameCaseMapped width mapping", args{PRECISUsernameCaseMapped, "\U0000FF21\U0000FF22\U0000FF23"}, "abc", false},
+		{"TestCase:UsernameCaseMapped keeps sharp s", args{PRECISUsernameCaseMapped, "\U000000DF"}, "\U000000DF", false},
+		{"TestCase:UsernameCaseMapped space", args{PRECISUsernameCaseMapped, "a b"}, "", true},
+		{"TestCase:UsernameCasePreserved", args{PRECISUsernameCasePreserved, "\U0000FF21b"}, "Ab", false},
+		{"TestCase:OpaqueString", args{PRECISOpaqueString, "Correct Horse"}, "Correct Horse", false},
+		{"TestCase:OpaqueString non-ASCII space", args{PRECISOpaqueString, "a\U000000A0b"}, "a b", false},
+		{"TestCase:OpaqueString empty", args{PRECISOpaqueString, ""}, "", true},
+		{"TestCase:IdentifierClass", args{PRECISIdentifierClass, "ABC"}, "ABC", false},
+		{"TestCase:IdentifierClass compatibility character", args{PRECISIdentifierClass, "\U00002168"}, "", true},
+		{"TestCase:FreeformClass", args{PRECISFreeformClass, "a \U00002168"}, "a \U00002168", false},
+		{"TestCase:case-insensitive profile name", args{"usernamecasemapped", "ABC"}, "abc", false},
+		{"TestCase:unknown profile", args{"Nickname", "abc"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PreparePRECIS(tt.args.profile, tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PreparePRECIS() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("PreparePRECIS() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComparePRECIS(t *testing.T) {
+	type args struct {
+		values []string
+	}
+	type difference struct {
+		value              string
+		caseIgnore         string
+		usernameCaseMapped string
+		reason             string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []difference
+	}{
+		{"TestCase:same forms", args{[]string{"ABC", "\U0000FF21BC", "\U000003A3"}}, []difference{}},
+		{"TestCase:sharp s", args{[]string{"Stra\U000000DFe"}}, []difference{{"Stra\U000000DFe", "strasse", "stra\U000000DFe", "different forms"}}},
+		{"TestCase:space", args{[]string{" a  b "}}, []difference{{" a  b ", "a b", "", "rejected by " + PRECISUsernameCaseMapped}}},
+		{"TestCase:compatibility character", args{[]string{"\U00002168"}}, []difference{{"\U00002168", "ix", "", "rejected by " + PRECISUsernameCaseMapped}}},
+		{"TestCase:prohibited", args{[]string{"a\U0000E000"}}, []difference{{"a\U0000E000", "", "", "rejected by both"}}},
+		{"TestCase:unassigned in RFC 3454", args{[]string{"\U00000221"}}, []difference{{"\U00000221", "", "\U00000221", "rejected by " + CaseIgnoreMatch}}},
+		{"TestCase:order", args{[]string{"\U000000DF", "abc", "a b"}}, []difference{
+			{"\U000000DF", "ss", "\U000000DF", "different forms"},
+			{"a b", "a b", "", "rejected by " + PRECISUsernameCaseMapped},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ComparePRECIS(tt.args.values)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ComparePRECIS() = %+v, want %+v", got, tt.want)
+			}
+			for i, d := range got {
+				w := tt.want[i]
+				if d.Value != w.value || d.CaseIgnore != w.caseIgnore || d.UsernameCaseMapped != w.usernameCaseMapped || d.Reason() != w.reason {
+					t.Errorf("ComparePRECIS()[%d] = %+v (%s), want %+v", i, d, d.Reason(), w)
+				}
+			}
+		})
+	}
+}
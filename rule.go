@@ -0,0 +1,290 @@
+package ldapstrprep
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+//Names of the RFC 4517 matching rules whose assertion and attribute values are prepared by this package.
+//https://tools.ietf.org/html/rfc4517#section-4.2
+const (
+	CaseExactIA5Match              = "caseExactIA5Match"
+	CaseExactMatch                 = "caseExactMatch"
+	CaseExactOrderingMatch         = "caseExactOrderingMatch"
+	CaseExactSubstringsMatch       = "caseExactSubstringsMatch"
+	CaseIgnoreIA5Match             = "caseIgnoreIA5Match"
+	CaseIgnoreIA5SubstringsMatch   = "caseIgnoreIA5SubstringsMatch"
+	CaseIgnoreListMatch            = "caseIgnoreListMatch"
+	CaseIgnoreListSubstringsMatch  = "caseIgnoreListSubstringsMatch"
+	CaseIgnoreMatch                = "caseIgnoreMatch"
+	CaseIgnoreOrderingMatch        = "caseIgnoreOrderingMatch"
+	CaseIgnoreSubstringsMatch      = "caseIgnoreSubstringsMatch"
+	NumericStringMatch             = "numericStringMatch"
+	NumericStringOrderingMatch     = "numericStringOrderingMatch"
+	NumericStringSubstringsMatch   = "numericStringSubstringsMatch"
+	TelephoneNumberMatch           = "telephoneNumberMatch"
+	TelephoneNumberSubstringsMatch = "telephoneNumberSubstringsMatch"
+)
+
+//Names of the RFC 4517 matching rules whose values are not prepared by Prepare, and which SupportsRule reports as unsupported.
+//Values of wordMatch and keywordMatch are matched by MatchWord and MatchKeyword, and the first components
+//of values of directoryStringFirstComponentMatch and objectIdentifierFirstComponentMatch are prepared by PrepareFirstComponent.
+//https://tools.ietf.org/html/rfc4517#section-4.2
+const (
+	DirectoryStringFirstComponentMatch  = "directoryStringFirstComponentMatch"
+	KeywordMatch                        = "keywordMatch"
+	ObjectIdentifierFirstComponentMatch = "objectIdentifierFirstComponentMatch"
+	WordMatch                           = "wordMatch"
+)
+
+//matchingRuleOIDs maps the object identifiers of the RFC 4517 matching rules to their names.
+//https://tools.ietf.org/html/rfc4517#section-4.2
+var matchingRuleOIDs = map[string]string{
+	"2.5.13.16":                  "bitStringMatch",
+	"2.5.13.13":                  "booleanMatch",
+	"1.3.6.1.4.1.1466.109.114.1": CaseExactIA5Match,
+	"2.5.13.5":                   CaseExactMatch,
+	"2.5.13.6":                   CaseExactOrderingMatch,
+	"2.5.13.7":                   CaseExactSubstringsMatch,
+	"1.3.6.1.4.1.1466.109.114.2": CaseIgnoreIA5Match,
+	"1.3.6.1.4.1.1466.109.114.3": CaseIgnoreIA5SubstringsMatch,
+	"2.5.13.11":                  CaseIgnoreListMatch,
+	"2.5.13.12":                  CaseIgnoreListSubstringsMatch,
+	"2.5.13.2":                   CaseIgnoreMatch,
+	"2.5.13.3":                   CaseIgnoreOrderingMatch,
+	"2.5.13.4":                   CaseIgnoreSubstringsMatch,
+	"2.5.13.31":                  DirectoryStringFirstComponentMatch,
+	"2.5.13.1":                   "distinguishedNameMatch",
+	"2.5.13.27":                  "generalizedTimeMatch",
+	"2.5.13.28":                  "generalizedTimeOrderingMatch",
+	"2.5.13.29":                  "integerFirstComponentMatch",
+	"2.5.13.14":                  "integerMatch",
+	"2.5.13.15":                  "integerOrderingMatch",
+	"2.5.13.33":                  KeywordMatch,
+	"2.5.13.8":                   NumericStringMatch,
+	"2.5.13.9":                   NumericStringOrderingMatch,
+	"2.5.13.10":                  NumericStringSubstringsMatch,
+	"2.5.13.30":                  ObjectIdentifierFirstComponentMatch,
+	"2.5.13.0":                   "objectIdentifierMatch",
+	"2.5.13.17":                  "octetStringMatch",
+	"2.5.13.18":                  "octetStringOrderingMatch",
+	"2.5.13.20":                  TelephoneNumberMatch,
+	"2.5.13.21":                  TelephoneNumberSubstringsMatch,
+	"2.5.13.23":                  "uniqueMemberMatch",
+	"2.5.13.32":                  WordMatch,
+}
+
+//matchingRuleName returns the RFC 4517 name of the matching rule identified by oid.
+//If oid is not a known object identifier, oid is returned as it is.
+func matchingRuleName(oid string) string {
+	if name, ok := matchingRuleOIDs[strings.TrimSpace(oid)]; ok {
+		return name
+	}
+	return oid
+}
+
+//prepare applies the string preparation steps from Transcode to IsProhibited to s.
+//https://tools.ietf.org/html/rfc4518#section-2
+func prepare(s string, caseFolding bool) ([]rune, error) {
+	src, err := TranscodeStrict(s)
+	if err != nil {
+		return nil, err
+	}
+	dst := Normalize(MapCharacters(src, caseFolding))
+	if _, err := IsProhibited(dst); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+//ruleProfile is the string preparation of a matching rule.
+type ruleProfile struct {
+	//caseFolding is passed to MapCharacters.
+	caseFolding bool
+	//handling is the Insignificant Character Handling of attribute values and non-substring assertion values.
+	handling func(src []rune) []rune
+	//substringSpaceHandling reports whether substring assertion values are handled by the Insignificant Space Handling
+	//for their positions in PrepareSubstring, instead of handling.
+	substringSpaceHandling bool
+	//ia5 reports whether values must be IA5 strings, whose case folding only affects ASCII letters.
+	ia5 bool
+}
+
+//prepare applies the string preparation steps from Transcode to IsProhibited to s for p.
+//A SyntaxError is returned if p.ia5 is true and s has a non-IA5 character.
+func (p ruleProfile) prepare(s string) ([]rune, error) {
+	if p.ia5 {
+		if err := ValidateIA5String(s); err != nil {
+			return nil, err
+		}
+	}
+	return prepare(s, p.caseFolding)
+}
+
+//ruleProfiles maps the lower-cased names of matching rules to their string preparation.
+//https://tools.ietf.org/html/rfc4518#section-2.2
+//https://tools.ietf.org/html/rfc4518#section-2.6
+//https://tools.ietf.org/html/rfc4517#section-4.2.3
+//https://tools.ietf.org/html/rfc4517#section-4.2.9
+//https://tools.ietf.org/html/rfc4517#section-4.2.10
+var ruleProfiles = map[string]ruleProfile{
+	strings.ToLower(CaseExactIA5Match):              {false, ApplyInsignificantSpaceHandling, true, true},
+	strings.ToLower(CaseExactMatch):                 {false, ApplyInsignificantSpaceHandling, true, false},
+	strings.ToLower(CaseExactOrderingMatch):         {false, ApplyInsignificantSpaceHandling, true, false},
+	strings.ToLower(CaseExactSubstringsMatch):       {false, ApplyInsignificantSpaceHandling, true, false},
+	strings.ToLower(CaseIgnoreIA5Match):             {true, ApplyInsignificantSpaceHandling, true, true},
+	strings.ToLower(CaseIgnoreIA5SubstringsMatch):   {true, ApplyInsignificantSpaceHandling, true, true},
+	strings.ToLower(CaseIgnoreMatch):                {true, ApplyInsignificantSpaceHandling, true, false},
+	strings.ToLower(CaseIgnoreOrderingMatch):        {true, ApplyInsignificantSpaceHandling, true, false},
+	strings.ToLower(CaseIgnoreSubstringsMatch):      {true, ApplyInsignificantSpaceHandling, true, false},
+	strings.ToLower(NumericStringMatch):             {true, ApplyNumericStringInsignificantCharacterHandling, false, false},
+	strings.ToLower(NumericStringOrderingMatch):     {true, ApplyNumericStringInsignificantCharacterHandling, false, false},
+	strings.ToLower(NumericStringSubstringsMatch):   {true, ApplyNumericStringInsignificantCharacterHandling, false, false},
+	strings.ToLower(TelephoneNumberMatch):           {true, ApplyTelephoneNumberInsignificantCharacterHandling, false, false},
+	strings.ToLower(TelephoneNumberSubstringsMatch): {true, ApplyTelephoneNumberInsignificantCharacterHandling, false, false},
+}
+
+//Prepare prepares s, which is an attribute value or a non-substring assertion value, for the matching rule named rule.
+//rule is a name or an OID of a matching rule, such as "caseIgnoreMatch" or "2.5.13.2".
+//The values of caseIgnoreListMatch and caseIgnoreListSubstringsMatch are prepared by PrepareList,
+//and the prepared lines are escaped and joined with dollar signs.
+//The values of the IA5 matching rules, such as caseIgnoreIA5Match, must be IA5 strings, or a SyntaxError is returned.
+//Prepare is idempotent: preparing a prepared value returns it as it is. See IsPrepared.
+//https://tools.ietf.org/html/rfc4518#section-2
+func Prepare(rule string, s string) ([]rune, error) {
+	name, err := lookupRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	if name == strings.ToLower(CaseIgnoreListMatch) || name == strings.ToLower(CaseIgnoreListSubstringsMatch) {
+		lines, err := PrepareList(s)
+		if err != nil {
+			return nil, err
+		}
+		return joinList(lines), nil
+	}
+	p := ruleProfiles[name]
+	dst, err := p.prepare(s)
+	if err != nil {
+		return nil, err
+	}
+	return p.handling(dst), nil
+}
+
+//IsPrepared reports whether s is a prepared value for the matching rule named rule, that is, Prepare(rule, s) returns s.
+//Prepare is idempotent, so a value returned by Prepare is prepared, and need not be prepared again when it is stored.
+//IsPrepared does not map nor normalize ASCII strings, so it is cheaper than Prepare for them.
+//IsPrepared returns false if rule is not supported.
+func IsPrepared(rule string, s string) bool {
+	name, err := lookupRule(rule)
+	if err != nil {
+		return false
+	}
+	if p, ok := ruleProfiles[name]; ok {
+		if prepared, ascii := isMappedASCII(s, p.caseFolding); ascii {
+			src := []rune(s)
+			return prepared && compareRunes(p.handling(src), src) == 0
+		}
+	}
+	dst, err := Prepare(rule, s)
+	return err == nil && string(dst) == s
+}
+
+//isMappedASCII reports whether s is an ASCII string, and if so, whether MapCharacters keeps s as it is.
+//Control characters are mapped to nothing or SPACE (U+0020), and upper case letters are mapped to lower case ones
+//if caseFolding is true. NFKC and the prohibited code points do not affect ASCII strings.
+func isMappedASCII(s string, caseFolding bool) (mapped bool, ascii bool) {
+	mapped = true
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c >= utf8.RuneSelf:
+			return false, false
+		case c < 0X20 || c == 0X7F:
+			mapped = false
+		case caseFolding && c >= 'A' && c <= 'Z':
+			mapped = false
+		}
+	}
+	return mapped, true
+}
+
+//SupportsRule reports whether the values of the matching rule named rule are prepared by Prepare.
+//It returns false for wordMatch and keywordMatch, which are matched by MatchWord and MatchKeyword,
+//and for directoryStringFirstComponentMatch and objectIdentifierFirstComponentMatch, whose values are handled
+//by PrepareFirstComponent.
+func SupportsRule(rule string) bool {
+	_, err := lookupRule(rule)
+	return err == nil
+}
+
+//RuleCaseFolding reports whether the string preparation of the matching rule named rule maps upper case letters
+//to lower case ones, that is, the caseFolding passed to MapCharacters. It is true for the caseIgnoreList matching rules.
+//An error is returned if rule is not supported by Prepare.
+func RuleCaseFolding(rule string) (bool, error) {
+	name, err := lookupRule(rule)
+	if err != nil {
+		return false, err
+	}
+	if p, ok := ruleProfiles[name]; ok {
+		return p.caseFolding, nil
+	}
+	return true, nil
+}
+
+//SubstringPosition is the position of a substring in a substring assertion.
+type SubstringPosition int
+
+//Positions of substrings.
+const (
+	SubstringInitial SubstringPosition = iota
+	SubstringAny
+	SubstringFinal
+)
+
+//PrepareSubstring prepares s, which is the substring at pos of a substring assertion, for the matching rule named rule.
+//Insignificant Space Handling for the substring at pos is applied for the case matching rules, including the IA5 ones,
+//and caseIgnoreList matching rules. The Insignificant Character Handling of the other matching rules does not depend on pos.
+//https://tools.ietf.org/html/rfc4518#section-2.6.1
+func PrepareSubstring(rule string, s string, pos SubstringPosition) ([]rune, error) {
+	name, err := lookupRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := ruleProfiles[name]
+	if !ok {
+		//caseIgnoreListMatch and caseIgnoreListSubstringsMatch
+		p = ruleProfiles[strings.ToLower(CaseIgnoreSubstringsMatch)]
+	}
+	dst, err := p.prepare(s)
+	if err != nil {
+		return nil, err
+	}
+	if !p.substringSpaceHandling {
+		return p.handling(dst), nil
+	}
+	switch pos {
+	case SubstringInitial:
+		return ApplyInsignificantSpaceHandlingInitial(dst), nil
+	case SubstringAny:
+		return ApplyInsignificantSpaceHandlingAny(dst), nil
+	case SubstringFinal:
+		return ApplyInsignificantSpaceHandlingFinal(dst), nil
+	default:
+		return nil, fmt.Errorf("ldapstrprep: invalid substring position %d", pos)
+	}
+}
+
+//lookupRule returns the lower-cased name of the matching rule named rule.
+//An error is returned if the values of the matching rule are not prepared by this package.
+func lookupRule(rule string) (string, error) {
+	name := strings.ToLower(matchingRuleName(rule))
+	if _, ok := ruleProfiles[name]; ok {
+		return name, nil
+	}
+	if name == strings.ToLower(CaseIgnoreListMatch) || name == strings.ToLower(CaseIgnoreListSubstringsMatch) {
+		return name, nil
+	}
+	return "", fmt.Errorf("ldapstrprep: unsupported matching rule %q", rule)
+}
@@ -0,0 +1,268 @@
+package ldapstrprep
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPrepare(t *testing.T) {
+	type args struct {
+		rule string
+		s    string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []rune
+		wantErr bool
+	}{
+		{"TestCase:caseIgnoreMatch", args{CaseIgnoreMatch, "  John   SMITH "}, []rune(" john  smith "), false},
+		{"TestCase:caseIgnoreMatch fullwidth", args{CaseIgnoreMatch, "ＡＢＣ"}, []rune(" abc "), false},
+		{"TestCase:caseIgnoreMatch by OID", args{"2.5.13.2", "ABC"}, []rune(" abc "), false},
+		{"TestCase:case-insensitive rule name", args{"CASEIGNOREORDERINGMATCH", "ABC"}, []rune(" abc "), false},
+		{"TestCase:caseExactMatch", args{CaseExactMatch, "  John   SMITH "}, []rune(" John  SMITH "), false},
+		{"TestCase:caseExactSubstringsMatch", args{CaseExactSubstringsMatch, "ＡＢＣ"}, []rune(" ABC "), false},
+		{"TestCase:numericStringMatch", args{NumericStringMatch, " 1 234 567 "}, []rune("1234567"), false},
+		{"TestCase:telephoneNumberMatch", args{TelephoneNumberMatch, "+1 512-315-0280"}, []rune("+15123150280"), false},
+		{"TestCase:caseIgnoreListMatch", args{CaseIgnoreListMatch, `A$B\24$C\5C`}, []rune(` a $ b\24 $ c\5C `), false},
+		{"TestCase:caseIgnoreIA5Match", args{CaseIgnoreIA5Match, " Foo\tBAR "}, []rune(" foo  bar "), false},
+		{"TestCase:caseExactIA5Match", args{CaseExactIA5Match, " Foo  BAR"}, []rune(" Foo  BAR "), false},
+		{"TestCase:caseIgnoreIA5Match by OID", args{"1.3.6.1.4.1.1466.109.114.2", "ABC"}, []rune(" abc "), false},
+		{"TestCase:caseIgnoreIA5Match non-IA5", args{CaseIgnoreIA5Match, "\u00C4BC"}, nil, true},
+		{"TestCase:caseIgnoreIA5Match fullwidth", args{CaseIgnoreIA5Match, "ＡＢＣ"}, nil, true},
+		{"TestCase:prohibited", args{CaseIgnoreMatch, "a\U0000E000"}, nil, true},
+		{"TestCase:invalid UTF-8", args{CaseIgnoreMatch, "a\xFF"}, nil, true},
+		{"TestCase:unsupported rule", args{"integerMatch", "1"}, nil, true},
+		{"TestCase:unknown rule", args{"fooMatch", "1"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Prepare(tt.args.rule, tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Prepare() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Prepare() = %q, want %q", string(got), string(tt.want))
+			}
+		})
+	}
+}
+
+func TestPrepareSubstring(t *testing.T) {
+	type args struct {
+		rule string
+		s    string
+		pos  SubstringPosition
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []rune
+		wantErr bool
+	}{
+		{"TestCase:caseIgnoreSubstringsMatch initial", args{CaseIgnoreSubstringsMatch, "  John   SMITH  ", SubstringInitial}, []rune(" john  smith "), false},
+		{"TestCase:caseIgnoreSubstringsMatch initial without trailing space", args{CaseIgnoreSubstringsMatch, "  John", SubstringInitial}, []rune(" john"), false},
+		{"TestCase:caseIgnoreSubstringsMatch any", args{CaseIgnoreSubstringsMatch, " John ", SubstringAny}, []rune(" john "), false},
+		{"TestCase:caseExactSubstringsMatch final", args{CaseExactSubstringsMatch, "John  ", SubstringFinal}, []rune("John "), false},
+		{"TestCase:caseIgnoreListSubstringsMatch", args{CaseIgnoreListSubstringsMatch, "ＡＢ", SubstringAny}, []rune("ab"), false},
+		{"TestCase:caseIgnoreIA5SubstringsMatch initial", args{CaseIgnoreIA5SubstringsMatch, " Foo  BAR", SubstringInitial}, []rune(" foo  bar"), false},
+		{"TestCase:caseIgnoreIA5SubstringsMatch final", args{CaseIgnoreIA5SubstringsMatch, "Foo ", SubstringFinal}, []rune("foo "), false},
+		{"TestCase:caseIgnoreIA5SubstringsMatch non-IA5", args{CaseIgnoreIA5SubstringsMatch, "f\u00F6o", SubstringAny}, nil, true},
+		{"TestCase:numericStringSubstringsMatch", args{NumericStringSubstringsMatch, " 1 2 ", SubstringInitial}, []rune("12"), false},
+		{"TestCase:telephoneNumberSubstringsMatch", args{TelephoneNumberSubstringsMatch, "512-315", SubstringFinal}, []rune("512315"), false},
+		{"TestCase:prohibited", args{CaseIgnoreSubstringsMatch, "a\U0000E000", SubstringAny}, nil, true},
+		{"TestCase:unsupported rule", args{"integerMatch", "1", SubstringAny}, nil, true},
+		{"TestCase:invalid position", args{CaseIgnoreSubstringsMatch, "a", SubstringPosition(3)}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PrepareSubstring(tt.args.rule, tt.args.s, tt.args.pos)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PrepareSubstring() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PrepareSubstring() got = %q, want %q", string(got), string(tt.want))
+			}
+		})
+	}
+}
+
+func TestSupportsRule(t *testing.T) {
+	tests := []struct {
+		name string
+		rule string
+		want bool
+	}{
+		{"TestCase:caseIgnoreMatch", CaseIgnoreMatch, true},
+		{"TestCase:OID", "2.5.13.2", true},
+		{"TestCase:caseIgnoreListMatch", CaseIgnoreListMatch, true},
+		{"TestCase:caseExactIA5Match", CaseExactIA5Match, true},
+		{"TestCase:integerMatch", "integerMatch", false},
+		{"TestCase:wordMatch", WordMatch, false},
+		{"TestCase:directoryStringFirstComponentMatch", DirectoryStringFirstComponentMatch, false},
+		{"TestCase:empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SupportsRule(tt.rule); got != tt.want {
+				t.Errorf("SupportsRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleCaseFolding(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		want    bool
+		wantErr bool
+	}{
+		{"TestCase:caseIgnoreMatch", CaseIgnoreMatch, true, false},
+		{"TestCase:caseExactSubstringsMatch", CaseExactSubstringsMatch, false, false},
+		{"TestCase:caseExactIA5Match OID", "1.3.6.1.4.1.1466.109.114.1", false, false},
+		{"TestCase:caseIgnoreListMatch", CaseIgnoreListMatch, true, false},
+		{"TestCase:telephoneNumberMatch", TelephoneNumberMatch, true, false},
+		{"TestCase:unsupported rule", "integerMatch", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RuleCaseFolding(tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RuleCaseFolding() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("RuleCaseFolding() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrepare_nonIA5(t *testing.T) {
+	tests := []struct {
+		name       string
+		rule       string
+		s          string
+		wantOffset int
+	}{
+		{"TestCase:caseIgnoreIA5Match", CaseIgnoreIA5Match, "ab\u00E9", 2},
+		{"TestCase:caseExactIA5Match", CaseExactIA5Match, "\u00E9", 0},
+		{"TestCase:caseIgnoreIA5SubstringsMatch", CaseIgnoreIA5SubstringsMatch, "a\u212A", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Prepare(tt.rule, tt.s)
+			var e *SyntaxError
+			if !errors.As(err, &e) || e.Syntax != "IA5 String" || e.Offset != tt.wantOffset {
+				t.Errorf("Prepare() error = %v, want SyntaxError at offset %d", err, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestIsPrepared(t *testing.T) {
+	type args struct {
+		rule string
+		s    string
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"TestCase:prepared", args{CaseIgnoreMatch, " foo  bar "}, true},
+		{"TestCase:prepared with oid", args{"2.5.13.2", " foo  bar "}, true},
+		{"TestCase:no leading space", args{CaseIgnoreMatch, "foo  bar "}, false},
+		{"TestCase:one space between words", args{CaseIgnoreMatch, " foo bar "}, false},
+		{"TestCase:upper case", args{CaseIgnoreMatch, " Foo  bar "}, false},
+		{"TestCase:upper case for case exact", args{CaseExactMatch, " Foo  bar "}, true},
+		{"TestCase:control character", args{CaseExactMatch, " foo\U00000009 "}, false},
+		{"TestCase:no words", args{CaseIgnoreMatch, "  "}, true},
+		{"TestCase:blank", args{CaseIgnoreMatch, ""}, false},
+		{"TestCase:non-ASCII", args{CaseIgnoreMatch, " stra\U000000DFe "}, false},
+		{"TestCase:prepared non-ASCII", args{CaseIgnoreMatch, " strasse  \U000000E9t\U000000E9 "}, true},
+		{"TestCase:not normalized", args{CaseExactMatch, " e\U00000301 "}, false},
+		{"TestCase:combining mark first", args{CaseIgnoreMatch, "  \U00000301a "}, true},
+		{"TestCase:prohibited", args{CaseIgnoreMatch, " \U0000E000 "}, false},
+		{"TestCase:numericString", args{NumericStringMatch, "1234"}, true},
+		{"TestCase:numericString with space", args{NumericStringMatch, "12 34"}, false},
+		{"TestCase:telephoneNumber", args{TelephoneNumberMatch, "+15123150280"}, true},
+		{"TestCase:telephoneNumber with hyphen", args{TelephoneNumberMatch, "+1-512-315-0280"}, false},
+		{"TestCase:caseIgnoreList", args{CaseIgnoreListMatch, " 1234  main  st. $ anytown "}, true},
+		{"TestCase:caseIgnoreList not prepared", args{CaseIgnoreListMatch, "1234 Main St.$Anytown"}, false},
+		{"TestCase:IA5", args{CaseIgnoreIA5Match, " user@example.com "}, true},
+		{"TestCase:unsupported rule", args{"integerMatch", "1"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsPrepared(tt.args.rule, tt.args.s); got != tt.want {
+				t.Errorf("IsPrepared() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrepare_idempotent(t *testing.T) {
+	positions := []SubstringPosition{SubstringInitial, SubstringAny, SubstringFinal}
+	for _, rule := range fuzzRules() {
+		for _, s := range fuzzSeeds {
+			got, err := Prepare(rule, s)
+			if err != nil {
+				continue
+			}
+			if again, err := Prepare(rule, string(got)); err != nil || !reflect.DeepEqual(again, got) {
+				t.Errorf("Prepare(%q, %q) = %q, %v, want %q", rule, string(got), string(again), err, string(got))
+			}
+			if !IsPrepared(rule, string(got)) {
+				t.Errorf("IsPrepared(%q, %q) = false, want true", rule, string(got))
+			}
+			if want := string(got) == s; IsPrepared(rule, s) != want {
+				t.Errorf("IsPrepared(%q, %q) = %v, want %v", rule, s, !want, want)
+			}
+			for _, pos := range positions {
+				sub, err := PrepareSubstring(rule, s, pos)
+				if err != nil {
+					continue
+				}
+				if again, err := PrepareSubstring(rule, string(sub), pos); err != nil || !reflect.DeepEqual(again, sub) {
+					t.Errorf("PrepareSubstring(%q, %q, %d) = %q, %v, want %q", rule, string(sub), pos, string(again), err, string(sub))
+				}
+			}
+		}
+	}
+}
+
+func TestPrepare_idempotentSteps(t *testing.T) {
+	handlers := map[string]func(src []rune) []rune{
+		"ApplyInsignificantSpaceHandling":                    ApplyInsignificantSpaceHandling,
+		"ApplyInsignificantSpaceHandlingInitial":             ApplyInsignificantSpaceHandlingInitial,
+		"ApplyInsignificantSpaceHandlingFinal":               ApplyInsignificantSpaceHandlingFinal,
+		"ApplyInsignificantSpaceHandlingAny":                 ApplyInsignificantSpaceHandlingAny,
+		"ApplyNumericStringInsignificantCharacterHandling":   ApplyNumericStringInsignificantCharacterHandling,
+		"ApplyTelephoneNumberInsignificantCharacterHandling": ApplyTelephoneNumberInsignificantCharacterHandling,
+	}
+	steps := func(src []rune, caseFolding bool, handle func(src []rune) []rune) ([]rune, error) {
+		dst := Normalize(MapCharacters(src, caseFolding))
+		if _, err := IsProhibited(dst); err != nil {
+			return nil, err
+		}
+		return handle(dst), nil
+	}
+	for name, handle := range handlers {
+		for _, caseFolding := range []bool{false, true} {
+			for _, s := range fuzzSeeds {
+				got, err := steps(Transcode(s), caseFolding, handle)
+				if err != nil {
+					continue
+				}
+				if again, err := steps(got, caseFolding, handle); err != nil || !reflect.DeepEqual(again, got) {
+					t.Errorf("%s(%q), caseFolding %v = %q, %v, want %q", name, string(got), caseFolding, string(again), err, string(got))
+				}
+			}
+		}
+	}
+}
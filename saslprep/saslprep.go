@@ -0,0 +1,77 @@
+//Package saslprep implements SASLprep described in RFC 4013 (SASLprep: Stringprep Profile for User Names and Passwords),
+//which prepares the user names and passwords of LDAP simple and SASL binds, such as SCRAM.
+/*
+Stored strings, which are stored in the directory, are prepared by PrepareStored, which prohibits unassigned code points.
+Query strings, which are given by clients, are prepared by PrepareQuery, which allows them.
+*/
+package saslprep
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/tardevnull/ldapstrprep/stringprep"
+)
+
+var (
+	//nonASCIISpaceTable maps the non-ASCII space characters to SPACE (U+0020).
+	//https://tools.ietf.org/html/rfc4013#section-2.1
+	nonASCIISpaceTable = stringprep.NewMapping(stringprep.TableC12, []rune{0X0020})
+
+	//Stored is the SASLprep profile for stored strings.
+	//https://tools.ietf.org/html/rfc4013#section-2
+	Stored = &stringprep.Profile{
+		Mappings: []stringprep.Mapping{nonASCIISpaceTable, stringprep.TableB1},
+		NFKC:     true,
+		Prohibited: []stringprep.Set{
+			stringprep.TableC12,
+			stringprep.TableC21,
+			stringprep.TableC22,
+			stringprep.TableC3,
+			stringprep.TableC4,
+			stringprep.TableC5,
+			stringprep.TableC6,
+			stringprep.TableC7,
+			stringprep.TableC8,
+			stringprep.TableC9,
+		},
+		Unassigned: stringprep.TableA1,
+		RandAL:     stringprep.TableD1,
+		L:          stringprep.TableD2,
+	}
+
+	//Query is the SASLprep profile for query strings, which is Stored but allows unassigned code points.
+	//https://tools.ietf.org/html/rfc4013#section-2.5
+	Query = &stringprep.Profile{
+		Mappings:        Stored.Mappings,
+		NFKC:            Stored.NFKC,
+		Prohibited:      Stored.Prohibited,
+		Unassigned:      Stored.Unassigned,
+		AllowUnassigned: true,
+		RandAL:          Stored.RandAL,
+		L:               Stored.L,
+	}
+)
+
+//PrepareStored prepares s as a stored string, such as a password stored in userPassword.
+//An error is returned if s is not valid UTF-8, or the prepared string has a prohibited or unassigned code point,
+//or does not satisfy the requirements of bidirectional characters.
+//https://tools.ietf.org/html/rfc4013#section-2
+func PrepareStored(s string) (string, error) {
+	return prepare(Stored, s)
+}
+
+//PrepareQuery prepares s as a query string, such as a password given by a bind request.
+//Unlike PrepareStored, unassigned code points are allowed.
+//https://tools.ietf.org/html/rfc4013#section-2.5
+func PrepareQuery(s string) (string, error) {
+	return prepare(Query, s)
+}
+
+//prepare prepares s with p.
+func prepare(p *stringprep.Profile, s string) (string, error) {
+	if !utf8.ValidString(s) {
+		return "", fmt.Errorf("saslprep: invalid UTF-8 string %q", s)
+	}
+	return p.PrepareString(s)
+}
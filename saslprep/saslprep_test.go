@@ -0,0 +1,101 @@
+package saslprep
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tardevnull/ldapstrprep/stringprep"
+)
+
+//https://tools.ietf.org/html/rfc4013#section-3
+func TestPrepareStored(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{"TestCase:RFC 4013 #1 SOFT HYPHEN mapped to nothing", args{"I\U000000ADX"}, "IX", false},
+		{"TestCase:RFC 4013 #2 no transformation", args{"user"}, "user", false},
+		{"TestCase:RFC 4013 #3 case preserved", args{"USER"}, "USER", false},
+		{"TestCase:RFC 4013 #4 output is NFKC", args{"\U000000AA"}, "a", false},
+		{"TestCase:RFC 4013 #5 output is NFKC", args{"\U00002168"}, "IX", false},
+		{"TestCase:RFC 4013 #6 prohibited character", args{"\U00000007"}, "", true},
+		{"TestCase:RFC 4013 #7 bidirectional check", args{"\U00000627\U00000031"}, "", true},
+		{"TestCase:non-ASCII space mapped to SPACE", args{"a\U00003000b"}, "a b", false},
+		{"TestCase:ASCII space", args{"a b"}, "a b", false},
+		{"TestCase:right-to-left", args{"\U00000627\U00000031\U00000628"}, "\U00000627\U00000031\U00000628", false},
+		{"TestCase:tagging character", args{"a\U000E0041"}, "", true},
+		{"TestCase:unassigned", args{"a\U00000221"}, "", true},
+		{"TestCase:invalid UTF-8", args{"a\xFF"}, "", true},
+		{"TestCase:blank", args{""}, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PrepareStored(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PrepareStored() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("PrepareStored() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrepareQuery(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{"TestCase:RFC 4013 #1 SOFT HYPHEN mapped to nothing", args{"I\U000000ADX"}, "IX", false},
+		{"TestCase:RFC 4013 #5 output is NFKC", args{"\U00002168"}, "IX", false},
+		{"TestCase:RFC 4013 #6 prohibited character", args{"\U00000007"}, "", true},
+		{"TestCase:RFC 4013 #7 bidirectional check", args{"\U00000627\U00000031"}, "", true},
+		{"TestCase:unassigned", args{"a\U00000221"}, "a\U00000221", false},
+		{"TestCase:private use", args{"a\U0000E000"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PrepareQuery(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PrepareQuery() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("PrepareQuery() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrepareStored_errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		s          string
+		wantRune   rune
+		unassigned bool
+	}{
+		{"TestCase:prohibited", "\U00000007", 0X0007, false},
+		{"TestCase:unassigned", "\U00000221", 0X0221, true},
+		{"TestCase:ASCII control", "a\U0000007F", 0X007F, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := PrepareStored(tt.s)
+			var e *stringprep.ProhibitedError
+			if !errors.As(err, &e) || e.Rune != tt.wantRune || e.Unassigned != tt.unassigned {
+				t.Errorf("PrepareStored() error = %v, want ProhibitedError of %U", err, tt.wantRune)
+			}
+		})
+	}
+}
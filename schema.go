@@ -0,0 +1,586 @@
+package ldapstrprep
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//AttributeTypeDescription is an attribute type definition described in RFC 4512 section 4.1.2.
+//https://tools.ietf.org/html/rfc4512#section-4.1.2
+type AttributeTypeDescription struct {
+	OID                string
+	Names              []string
+	Desc               string
+	Obsolete           bool
+	Sup                string
+	Equality           string
+	Ordering           string
+	Substr             string
+	Syntax             string
+	SyntaxLen          int
+	SingleValue        bool
+	Collective         bool
+	NoUserModification bool
+	Usage              string
+	Extensions         map[string][]string
+}
+
+//MatchingRuleDescription is a matching rule definition described in RFC 4512 section 4.1.3.
+//https://tools.ietf.org/html/rfc4512#section-4.1.3
+type MatchingRuleDescription struct {
+	OID        string
+	Names      []string
+	Desc       string
+	Obsolete   bool
+	Syntax     string
+	Extensions map[string][]string
+}
+
+//Name returns the first name of a, or the object identifier of a if a has no name.
+func (a *AttributeTypeDescription) Name() string {
+	if len(a.Names) != 0 {
+		return a.Names[0]
+	}
+	return a.OID
+}
+
+//Name returns the first name of m, or the object identifier of m if m has no name.
+func (m *MatchingRuleDescription) Name() string {
+	if len(m.Names) != 0 {
+		return m.Names[0]
+	}
+	return m.OID
+}
+
+//Schema is a set of attribute type and matching rule definitions, such as the subschema subentry of a server.
+//Schema looks up the matching rules of an attribute, resolving SUP inheritance.
+//The zero value is not usable; use NewSchema.
+type Schema struct {
+	attributeTypes map[string]*AttributeTypeDescription
+	matchingRules  map[string]*MatchingRuleDescription
+	//approxMatchers maps the lower-cased first names of attribute types to the matchers set by SetApproxMatcher.
+	approxMatchers map[string]ApproxMatcher
+}
+
+//NewSchema returns an empty Schema.
+func NewSchema() *Schema {
+	return &Schema{
+		attributeTypes: make(map[string]*AttributeTypeDescription),
+		matchingRules:  make(map[string]*MatchingRuleDescription),
+		approxMatchers: make(map[string]ApproxMatcher),
+	}
+}
+
+//AddAttributeType parses def as an AttributeTypeDescription and adds it to s.
+//A definition which has the same OID or name as an existing definition replaces it.
+func (s *Schema) AddAttributeType(def string) error {
+	a, err := ParseAttributeTypeDescription(def)
+	if err != nil {
+		return err
+	}
+	s.attributeTypes[a.OID] = a
+	for _, name := range a.Names {
+		s.attributeTypes[strings.ToLower(name)] = a
+	}
+	return nil
+}
+
+//AddMatchingRule parses def as a MatchingRuleDescription and adds it to s.
+//A definition which has the same OID or name as an existing definition replaces it.
+func (s *Schema) AddMatchingRule(def string) error {
+	m, err := ParseMatchingRuleDescription(def)
+	if err != nil {
+		return err
+	}
+	s.matchingRules[m.OID] = m
+	for _, name := range m.Names {
+		s.matchingRules[strings.ToLower(name)] = m
+	}
+	return nil
+}
+
+//AttributeType returns the definition of the attribute type named attr as it was added.
+//attr is an OID, a name or an attribute description with options, such as "cn;lang-ja".
+func (s *Schema) AttributeType(attr string) (*AttributeTypeDescription, bool) {
+	a, ok := s.attributeTypes[attributeTypeKey(attr)]
+	return a, ok
+}
+
+//MatchingRule returns the definition of the matching rule named rule.
+//rule is an OID or a name.
+func (s *Schema) MatchingRule(rule string) (*MatchingRuleDescription, bool) {
+	m, ok := s.matchingRules[strings.ToLower(strings.TrimSpace(rule))]
+	return m, ok
+}
+
+//ResolveAttributeType returns a copy of the definition of the attribute type named attr
+//whose EQUALITY, ORDERING, SUBSTR and SYNTAX are inherited from its supertypes if they are absent.
+//The matching rules are returned as their names, resolved from OIDs by s or RFC 4517.
+//https://tools.ietf.org/html/rfc4512#section-2.5.1
+func (s *Schema) ResolveAttributeType(attr string) (*AttributeTypeDescription, error) {
+	a, ok := s.AttributeType(attr)
+	if !ok {
+		return nil, fmt.Errorf("ldapstrprep: unknown attribute type %q", attr)
+	}
+	resolved := *a
+	visited := map[*AttributeTypeDescription]struct{}{a: {}}
+	for sup := a; sup.Sup != ""; {
+		next, ok := s.AttributeType(sup.Sup)
+		if !ok {
+			return nil, fmt.Errorf("ldapstrprep: unknown supertype %q of attribute type %s", sup.Sup, sup.Name())
+		}
+		if _, ok := visited[next]; ok {
+			return nil, fmt.Errorf("ldapstrprep: circular supertype chain of attribute type %s", a.Name())
+		}
+		visited[next] = struct{}{}
+		if resolved.Equality == "" {
+			resolved.Equality = next.Equality
+		}
+		if resolved.Ordering == "" {
+			resolved.Ordering = next.Ordering
+		}
+		if resolved.Substr == "" {
+			resolved.Substr = next.Substr
+		}
+		if resolved.Syntax == "" {
+			resolved.Syntax, resolved.SyntaxLen = next.Syntax, next.SyntaxLen
+		}
+		sup = next
+	}
+	resolved.Equality = s.matchingRuleName(resolved.Equality)
+	resolved.Ordering = s.matchingRuleName(resolved.Ordering)
+	resolved.Substr = s.matchingRuleName(resolved.Substr)
+	return &resolved, nil
+}
+
+//EqualityRule returns the name of the equality matching rule of the attribute type named attr.
+//It returns an empty string if attr is unknown or has no equality matching rule.
+func (s *Schema) EqualityRule(attr string) string {
+	if a, err := s.ResolveAttributeType(attr); err == nil {
+		return a.Equality
+	}
+	return ""
+}
+
+//OrderingRule returns the name of the ordering matching rule of the attribute type named attr.
+//It returns an empty string if attr is unknown or has no ordering matching rule.
+func (s *Schema) OrderingRule(attr string) string {
+	if a, err := s.ResolveAttributeType(attr); err == nil {
+		return a.Ordering
+	}
+	return ""
+}
+
+//SubstringsRule returns the name of the substrings matching rule of the attribute type named attr.
+//It returns an empty string if attr is unknown or has no substrings matching rule.
+func (s *Schema) SubstringsRule(attr string) string {
+	if a, err := s.ResolveAttributeType(attr); err == nil {
+		return a.Substr
+	}
+	return ""
+}
+
+//matchingRuleName returns the name of the matching rule identified by oid.
+//Names are looked up in s first, then in RFC 4517.
+func (s *Schema) matchingRuleName(oid string) string {
+	if !isNumericOID(oid) {
+		return oid
+	}
+	if m, ok := s.matchingRules[oid]; ok && len(m.Names) != 0 {
+		return m.Names[0]
+	}
+	return matchingRuleName(oid)
+}
+
+//attributeTypeKey returns the key of attributeTypes for the attribute description attr.
+//Options of attr are removed and names are case-insensitive.
+//https://tools.ietf.org/html/rfc4512#section-2.5
+func attributeTypeKey(attr string) string {
+	attr = strings.TrimSpace(attr)
+	if i := strings.IndexByte(attr, ';'); i >= 0 {
+		attr = attr[:i]
+	}
+	return strings.ToLower(attr)
+}
+
+//descriptionFieldKind is the kind of the value which follows a keyword in a RFC 4512 description.
+type descriptionFieldKind int
+
+const (
+	//fieldFlag is a keyword which has no value, such as OBSOLETE.
+	fieldFlag descriptionFieldKind = iota
+	//fieldOID is a keyword followed by an oid, such as SUP.
+	fieldOID
+	//fieldNOIDLen is a keyword followed by a numericoid with an optional length, such as SYNTAX.
+	fieldNOIDLen
+	//fieldQDString is a keyword followed by a qdstring, such as DESC.
+	fieldQDString
+	//fieldQDescrs is a keyword followed by qdescrs, such as NAME.
+	fieldQDescrs
+	//fieldWord is a keyword followed by a keyword value, such as USAGE.
+	fieldWord
+)
+
+//attributeTypeFields is the keywords of AttributeTypeDescription.
+var attributeTypeFields = map[string]descriptionFieldKind{
+	"NAME":                 fieldQDescrs,
+	"DESC":                 fieldQDString,
+	"OBSOLETE":             fieldFlag,
+	"SUP":                  fieldOID,
+	"EQUALITY":             fieldOID,
+	"ORDERING":             fieldOID,
+	"SUBSTR":               fieldOID,
+	"SYNTAX":               fieldNOIDLen,
+	"SINGLE-VALUE":         fieldFlag,
+	"COLLECTIVE":           fieldFlag,
+	"NO-USER-MODIFICATION": fieldFlag,
+	"USAGE":                fieldWord,
+}
+
+//matchingRuleFields is the keywords of MatchingRuleDescription.
+var matchingRuleFields = map[string]descriptionFieldKind{
+	"NAME":     fieldQDescrs,
+	"DESC":     fieldQDString,
+	"OBSOLETE": fieldFlag,
+	"SYNTAX":   fieldNOIDLen,
+}
+
+//description is a RFC 4512 description which is split into its numericoid, its fields and its extensions.
+type description struct {
+	oid        string
+	fields     map[string][]string
+	extensions map[string][]string
+}
+
+//descriptionToken is a token of a RFC 4512 description.
+type descriptionToken struct {
+	text   string
+	quoted bool
+}
+
+//ParseAttributeTypeDescription parses s as an AttributeTypeDescription.
+//https://tools.ietf.org/html/rfc4512#section-4.1.2
+func ParseAttributeTypeDescription(s string) (*AttributeTypeDescription, error) {
+	d, err := parseDescription(s, attributeTypeFields)
+	if err != nil {
+		return nil, err
+	}
+	a := &AttributeTypeDescription{
+		OID:                d.oid,
+		Names:              d.fields["NAME"],
+		Desc:               d.first("DESC"),
+		Obsolete:           d.has("OBSOLETE"),
+		Sup:                d.first("SUP"),
+		Equality:           d.first("EQUALITY"),
+		Ordering:           d.first("ORDERING"),
+		Substr:             d.first("SUBSTR"),
+		SingleValue:        d.has("SINGLE-VALUE"),
+		Collective:         d.has("COLLECTIVE"),
+		NoUserModification: d.has("NO-USER-MODIFICATION"),
+		Usage:              d.first("USAGE"),
+		Extensions:         d.extensions,
+	}
+	if a.Syntax, a.SyntaxLen, err = splitNOIDLen(d.first("SYNTAX")); err != nil {
+		return nil, err
+	}
+	switch a.Usage {
+	case "", "userApplications", "directoryOperation", "distributedOperation", "dSAOperation":
+	default:
+		return nil, fmt.Errorf("ldapstrprep: invalid USAGE %q in attribute type %s", a.Usage, a.OID)
+	}
+	if a.Sup == "" && a.Syntax == "" {
+		return nil, fmt.Errorf("ldapstrprep: attribute type %s has neither SUP nor SYNTAX", a.OID)
+	}
+	return a, nil
+}
+
+//ParseMatchingRuleDescription parses s as a MatchingRuleDescription.
+//https://tools.ietf.org/html/rfc4512#section-4.1.3
+func ParseMatchingRuleDescription(s string) (*MatchingRuleDescription, error) {
+	d, err := parseDescription(s, matchingRuleFields)
+	if err != nil {
+		return nil, err
+	}
+	m := &MatchingRuleDescription{
+		OID:        d.oid,
+		Names:      d.fields["NAME"],
+		Desc:       d.first("DESC"),
+		Obsolete:   d.has("OBSOLETE"),
+		Extensions: d.extensions,
+	}
+	if m.Syntax, _, err = splitNOIDLen(d.first("SYNTAX")); err != nil {
+		return nil, err
+	}
+	if m.Syntax == "" {
+		return nil, fmt.Errorf("ldapstrprep: matching rule %s has no SYNTAX", m.OID)
+	}
+	return m, nil
+}
+
+//first returns the first value of the field named keyword.
+func (d *description) first(keyword string) string {
+	if v := d.fields[keyword]; len(v) != 0 {
+		return v[0]
+	}
+	return ""
+}
+
+//has reports whether d has the field named keyword.
+func (d *description) has(keyword string) bool {
+	_, ok := d.fields[keyword]
+	return ok
+}
+
+//parseDescription parses s as a RFC 4512 description whose keywords are defined by kinds.
+//https://tools.ietf.org/html/rfc4512#section-4.1
+func parseDescription(s string, kinds map[string]descriptionFieldKind) (*description, error) {
+	tokens, err := tokenizeDescription(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) < 3 || !tokens[0].isSymbol("(") || !tokens[len(tokens)-1].isSymbol(")") {
+		return nil, fmt.Errorf("ldapstrprep: description %q is not enclosed in parentheses", s)
+	}
+	tokens = tokens[1 : len(tokens)-1]
+	if tokens[0].quoted || !isNumericOID(tokens[0].text) {
+		return nil, fmt.Errorf("ldapstrprep: invalid numericoid %q", tokens[0].text)
+	}
+	d := &description{
+		oid:        tokens[0].text,
+		fields:     make(map[string][]string),
+		extensions: make(map[string][]string),
+	}
+	for i := 1; i < len(tokens); {
+		t := tokens[i]
+		if t.quoted || t.isSymbol("(") || t.isSymbol(")") {
+			return nil, fmt.Errorf("ldapstrprep: unexpected %q in description %s", t.text, d.oid)
+		}
+		keyword := t.text
+		i++
+		if strings.HasPrefix(keyword, "X-") {
+			values, n, err := parseQDStrings(tokens[i:])
+			if err != nil {
+				return nil, fmt.Errorf("ldapstrprep: extension %s of %s: %v", keyword, d.oid, err)
+			}
+			d.extensions[keyword] = values
+			i += n
+			continue
+		}
+		kind, ok := kinds[keyword]
+		if !ok {
+			return nil, fmt.Errorf("ldapstrprep: unknown keyword %s in description %s", keyword, d.oid)
+		}
+		if _, ok := d.fields[keyword]; ok {
+			return nil, fmt.Errorf("ldapstrprep: duplicate keyword %s in description %s", keyword, d.oid)
+		}
+		values, n, err := parseDescriptionField(kind, tokens[i:])
+		if err != nil {
+			return nil, fmt.Errorf("ldapstrprep: %s of %s: %v", keyword, d.oid, err)
+		}
+		d.fields[keyword] = values
+		i += n
+	}
+	return d, nil
+}
+
+//parseDescriptionField parses the value of kind at the beginning of tokens,
+//and returns the values and the number of consumed tokens.
+func parseDescriptionField(kind descriptionFieldKind, tokens []descriptionToken) (values []string, n int, err error) {
+	switch kind {
+	case fieldFlag:
+		return []string{}, 0, nil
+	case fieldQDString:
+		if len(tokens) == 0 || !tokens[0].quoted {
+			return nil, 0, fmt.Errorf("qdstring is expected")
+		}
+		return []string{tokens[0].text}, 1, nil
+	case fieldQDescrs:
+		values, n, err = parseQDStrings(tokens)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, v := range values {
+			if !isDescr(v) {
+				return nil, 0, fmt.Errorf("invalid descr %q", v)
+			}
+		}
+		return values, n, nil
+	default:
+		//Some servers quote oids, so quoted values are also accepted.
+		if len(tokens) == 0 || tokens[0].isSymbol("(") || tokens[0].isSymbol(")") {
+			return nil, 0, fmt.Errorf("value is expected")
+		}
+		v := tokens[0].text
+		switch kind {
+		case fieldOID:
+			if !isOID(v) {
+				return nil, 0, fmt.Errorf("invalid oid %q", v)
+			}
+		case fieldNOIDLen:
+			if _, _, err := splitNOIDLen(v); err != nil {
+				return nil, 0, err
+			}
+		}
+		return []string{v}, 1, nil
+	}
+}
+
+//parseQDStrings parses a qdstring or a parenthesized list of qdstrings at the beginning of tokens.
+//https://tools.ietf.org/html/rfc4512#section-4.1
+func parseQDStrings(tokens []descriptionToken) (values []string, n int, err error) {
+	if len(tokens) == 0 {
+		return nil, 0, fmt.Errorf("qdstrings is expected")
+	}
+	if tokens[0].quoted {
+		return []string{tokens[0].text}, 1, nil
+	}
+	if !tokens[0].isSymbol("(") {
+		return nil, 0, fmt.Errorf("qdstrings is expected")
+	}
+	values = make([]string, 0, 0)
+	for i := 1; i < len(tokens); i++ {
+		if tokens[i].isSymbol(")") {
+			return values, i + 1, nil
+		}
+		if !tokens[i].quoted {
+			return nil, 0, fmt.Errorf("unexpected %q in qdstrings", tokens[i].text)
+		}
+		values = append(values, tokens[i].text)
+	}
+	return nil, 0, fmt.Errorf("qdstrings is not closed")
+}
+
+//isSymbol reports whether t is the unquoted symbol s.
+func (t descriptionToken) isSymbol(s string) bool {
+	return !t.quoted && t.text == s
+}
+
+//tokenizeDescription splits s into parentheses, quoted strings and words.
+//Quoted strings are unescaped as a dstring.
+//https://tools.ietf.org/html/rfc4512#section-4.1
+func tokenizeDescription(s string) ([]descriptionToken, error) {
+	tokens := make([]descriptionToken, 0, 0)
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case c == '(' || c == ')':
+			tokens = append(tokens, descriptionToken{text: string(c)})
+			i++
+		case c == '\'':
+			end := strings.IndexByte(s[i+1:], '\'')
+			if end < 0 {
+				return nil, fmt.Errorf("ldapstrprep: unterminated quoted string in %q", s)
+			}
+			text, err := unescapeDString(s[i+1 : i+1+end])
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, descriptionToken{text: text, quoted: true})
+			i += end + 2
+		default:
+			start := i
+			for i < len(s) && !strings.ContainsRune(" \t\r\n()'", rune(s[i])) {
+				i++
+			}
+			tokens = append(tokens, descriptionToken{text: s[start:i]})
+		}
+	}
+	return tokens, nil
+}
+
+//unescapeDString replaces the escape sequences \27 and \5C in s with ' and \.
+//https://tools.ietf.org/html/rfc4512#section-4.1
+func unescapeDString(s string) (string, error) {
+	if !strings.Contains(s, `\`) {
+		return s, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+2 < len(s) && (strings.EqualFold(s[i+1:i+3], "27") || strings.EqualFold(s[i+1:i+3], "5C")) {
+			if s[i+1] == '2' {
+				b.WriteByte('\'')
+			} else {
+				b.WriteByte('\\')
+			}
+			i += 2
+			continue
+		}
+		return "", fmt.Errorf("ldapstrprep: invalid escape sequence in %q", s)
+	}
+	return b.String(), nil
+}
+
+//splitNOIDLen splits s into a numericoid and a length.
+//The length is 0 if s has no length.
+//https://tools.ietf.org/html/rfc4512#section-4.1.2
+func splitNOIDLen(s string) (oid string, length int, err error) {
+	if s == "" {
+		return "", 0, nil
+	}
+	oid = s
+	if i := strings.IndexByte(s, '{'); i >= 0 {
+		if !strings.HasSuffix(s, "}") {
+			return "", 0, fmt.Errorf("ldapstrprep: invalid noidlen %q", s)
+		}
+		oid = s[:i]
+		if length, err = strconv.Atoi(s[i+1 : len(s)-1]); err != nil || length < 0 {
+			return "", 0, fmt.Errorf("ldapstrprep: invalid noidlen %q", s)
+		}
+	}
+	if !isNumericOID(oid) {
+		return "", 0, fmt.Errorf("ldapstrprep: invalid noidlen %q", s)
+	}
+	return oid, length, nil
+}
+
+//isOID reports whether s is an oid, that is a descr or a numericoid.
+//https://tools.ietf.org/html/rfc4512#section-1.4
+func isOID(s string) bool {
+	return isDescr(s) || isNumericOID(s)
+}
+
+//isDescr reports whether s is a descr (keystring).
+//https://tools.ietf.org/html/rfc4512#section-1.4
+func isDescr(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		isAlpha := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		if i == 0 && !isAlpha {
+			return false
+		}
+		if !isAlpha && !(c >= '0' && c <= '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+//isNumericOID reports whether s is a numericoid.
+//https://tools.ietf.org/html/rfc4512#section-1.4
+func isNumericOID(s string) bool {
+	numbers := strings.Split(s, ".")
+	if len(numbers) < 2 {
+		return false
+	}
+	for _, n := range numbers {
+		if n == "" || (len(n) > 1 && n[0] == '0') {
+			return false
+		}
+		for i := 0; i < len(n); i++ {
+			if n[i] < '0' || n[i] > '9' {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,148 @@
+package ldapstrprep
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseAttributeTypeDescription(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *AttributeTypeDescription
+		wantErr bool
+	}{
+		{"TestCase:SUP only", args{"( 2.5.4.3 NAME 'cn' SUP name )"},
+			&AttributeTypeDescription{OID: "2.5.4.3", Names: []string{"cn"}, Sup: "name", Extensions: map[string][]string{}}, false},
+		{"TestCase:RFC 4519 name", args{"( 2.5.4.41 NAME 'name' EQUALITY caseIgnoreMatch SUBSTR caseIgnoreSubstringsMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.15 )"},
+			&AttributeTypeDescription{OID: "2.5.4.41", Names: []string{"name"}, Equality: "caseIgnoreMatch", Substr: "caseIgnoreSubstringsMatch", Syntax: "1.3.6.1.4.1.1466.115.121.1.15", Extensions: map[string][]string{}}, false},
+		{"TestCase:NAME list, DESC, noidlen and flags", args{"( 2.5.4.3 NAME ( 'cn' 'commonName' ) DESC 'RFC4519: common name(s) for which the entity is known by' SYNTAX 1.3.6.1.4.1.1466.115.121.1.15{32768} SINGLE-VALUE NO-USER-MODIFICATION USAGE directoryOperation X-ORIGIN 'RFC 4519' )"},
+			&AttributeTypeDescription{OID: "2.5.4.3", Names: []string{"cn", "commonName"}, Desc: "RFC4519: common name(s) for which the entity is known by", Syntax: "1.3.6.1.4.1.1466.115.121.1.15", SyntaxLen: 32768, SingleValue: true, NoUserModification: true, Usage: "directoryOperation", Extensions: map[string][]string{"X-ORIGIN": {"RFC 4519"}}}, false},
+		{"TestCase:escaped DESC", args{`( 1.2.3.4 NAME 'x' DESC 'it\27s a \5Cpath' SUP name )`},
+			&AttributeTypeDescription{OID: "1.2.3.4", Names: []string{"x"}, Desc: `it's a \path`, Sup: "name", Extensions: map[string][]string{}}, false},
+		{"TestCase:EQUALITY as numericoid", args{"( 1.2.3.4 EQUALITY 2.5.13.2 SYNTAX 1.3.6.1.4.1.1466.115.121.1.15 OBSOLETE COLLECTIVE )"},
+			&AttributeTypeDescription{OID: "1.2.3.4", Equality: "2.5.13.2", Syntax: "1.3.6.1.4.1.1466.115.121.1.15", Obsolete: true, Collective: true, Extensions: map[string][]string{}}, false},
+		{"TestCase:no parentheses", args{"2.5.4.3 NAME 'cn' SUP name"}, nil, true},
+		{"TestCase:descr as oid", args{"( cn NAME 'cn' SUP name )"}, nil, true},
+		{"TestCase:neither SUP nor SYNTAX", args{"( 2.5.4.3 NAME 'cn' )"}, nil, true},
+		{"TestCase:unknown keyword", args{"( 2.5.4.3 NAME 'cn' SUP name FOO )"}, nil, true},
+		{"TestCase:duplicate keyword", args{"( 2.5.4.3 NAME 'cn' SUP name SUP name )"}, nil, true},
+		{"TestCase:unterminated qdstring", args{"( 2.5.4.3 NAME 'cn SUP name )"}, nil, true},
+		{"TestCase:invalid descr", args{"( 2.5.4.3 NAME '1cn' SUP name )"}, nil, true},
+		{"TestCase:invalid noidlen", args{"( 2.5.4.3 NAME 'cn' SYNTAX 1.3.6.1.4.1.1466.115.121.1.15{x} )"}, nil, true},
+		{"TestCase:invalid USAGE", args{"( 2.5.4.3 NAME 'cn' SUP name USAGE everyone )"}, nil, true},
+		{"TestCase:invalid escape", args{`( 2.5.4.3 NAME 'cn' DESC '\41' SUP name )`}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAttributeTypeDescription(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseAttributeTypeDescription() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseAttributeTypeDescription() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseMatchingRuleDescription(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *MatchingRuleDescription
+		wantErr bool
+	}{
+		{"TestCase:caseIgnoreMatch", args{"( 2.5.13.2 NAME 'caseIgnoreMatch' SYNTAX 1.3.6.1.4.1.1466.115.121.1.15 )"},
+			&MatchingRuleDescription{OID: "2.5.13.2", Names: []string{"caseIgnoreMatch"}, Syntax: "1.3.6.1.4.1.1466.115.121.1.15", Extensions: map[string][]string{}}, false},
+		{"TestCase:DESC and OBSOLETE", args{"( 1.2.3.4 NAME 'fooMatch' DESC 'foo' OBSOLETE SYNTAX 1.3.6.1.4.1.1466.115.121.1.15 X-ORIGIN ( 'a' 'b' ) )"},
+			&MatchingRuleDescription{OID: "1.2.3.4", Names: []string{"fooMatch"}, Desc: "foo", Obsolete: true, Syntax: "1.3.6.1.4.1.1466.115.121.1.15", Extensions: map[string][]string{"X-ORIGIN": {"a", "b"}}}, false},
+		{"TestCase:no SYNTAX", args{"( 2.5.13.2 NAME 'caseIgnoreMatch' )"}, nil, true},
+		{"TestCase:SUP is not a keyword of matching rules", args{"( 2.5.13.2 NAME 'caseIgnoreMatch' SUP name SYNTAX 1.3.6.1.4.1.1466.115.121.1.15 )"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMatchingRuleDescription(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseMatchingRuleDescription() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseMatchingRuleDescription() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchema_ResolveAttributeType(t *testing.T) {
+	s := NewSchema()
+	defs := []string{
+		"( 2.5.4.41 NAME 'name' EQUALITY caseIgnoreMatch SUBSTR caseIgnoreSubstringsMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.15{32768} )",
+		"( 2.5.4.3 NAME ( 'cn' 'commonName' ) SUP name )",
+		"( 1.2.3.4 NAME 'exactName' SUP cn EQUALITY 2.5.13.5 )",
+		"( 1.2.3.5 NAME 'customName' SUP name EQUALITY 1.2.3.100 )",
+		"( 1.2.3.6 NAME 'orphan' SUP nothing )",
+		"( 1.2.3.7 NAME 'loopA' SUP loopB )",
+		"( 1.2.3.8 NAME 'loopB' SUP loopA )",
+	}
+	for _, def := range defs {
+		if err := s.AddAttributeType(def); err != nil {
+			t.Fatalf("AddAttributeType(%q) error = %v", def, err)
+		}
+	}
+	if err := s.AddMatchingRule("( 1.2.3.100 NAME 'customMatch' SYNTAX 1.3.6.1.4.1.1466.115.121.1.15 )"); err != nil {
+		t.Fatalf("AddMatchingRule() error = %v", err)
+	}
+	type args struct {
+		attr string
+	}
+	tests := []struct {
+		name         string
+		args         args
+		wantEquality string
+		wantSubstr   string
+		wantSyntax   string
+		wantErr      bool
+	}{
+		{"TestCase:name", args{"name"}, "caseIgnoreMatch", "caseIgnoreSubstringsMatch", "1.3.6.1.4.1.1466.115.121.1.15", false},
+		{"TestCase:cn inherits from name", args{"cn"}, "caseIgnoreMatch", "caseIgnoreSubstringsMatch", "1.3.6.1.4.1.1466.115.121.1.15", false},
+		{"TestCase:case-insensitive name with options", args{"CommonName;lang-ja"}, "caseIgnoreMatch", "caseIgnoreSubstringsMatch", "1.3.6.1.4.1.1466.115.121.1.15", false},
+		{"TestCase:OID", args{"2.5.4.3"}, "caseIgnoreMatch", "caseIgnoreSubstringsMatch", "1.3.6.1.4.1.1466.115.121.1.15", false},
+		{"TestCase:overridden EQUALITY as RFC 4517 OID", args{"exactName"}, "caseExactMatch", "caseIgnoreSubstringsMatch", "1.3.6.1.4.1.1466.115.121.1.15", false},
+		{"TestCase:overridden EQUALITY as schema OID", args{"customName"}, "customMatch", "caseIgnoreSubstringsMatch", "1.3.6.1.4.1.1466.115.121.1.15", false},
+		{"TestCase:unknown attribute", args{"sn"}, "", "", "", true},
+		{"TestCase:unknown supertype", args{"orphan"}, "", "", "", true},
+		{"TestCase:circular supertypes", args{"loopA"}, "", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.ResolveAttributeType(tt.args.attr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResolveAttributeType() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.Equality != tt.wantEquality || got.Substr != tt.wantSubstr || got.Syntax != tt.wantSyntax {
+				t.Errorf("ResolveAttributeType() = (%q, %q, %q), want (%q, %q, %q)", got.Equality, got.Substr, got.Syntax, tt.wantEquality, tt.wantSubstr, tt.wantSyntax)
+			}
+			if got := s.EqualityRule(tt.args.attr); got != tt.wantEquality {
+				t.Errorf("EqualityRule() = %q, want %q", got, tt.wantEquality)
+			}
+			if got := s.SubstringsRule(tt.args.attr); got != tt.wantSubstr {
+				t.Errorf("SubstringsRule() = %q, want %q", got, tt.wantSubstr)
+			}
+		})
+	}
+	if a, _ := s.AttributeType("exactName"); a.Substr != "" {
+		t.Errorf("ResolveAttributeType() modified the added definition: %+v", a)
+	}
+}
@@ -0,0 +1,265 @@
+package ldapstrprep
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tardevnull/ldapstrprep/ldif"
+)
+
+//SchemaFileError is an error which occurred while reading a schema file.
+//Line is the line number where the erroneous definition starts.
+type SchemaFileError struct {
+	File string
+	Line int
+	Err  error
+}
+
+func (e *SchemaFileError) Error() string {
+	return fmt.Sprintf("%s:%d: %v", e.File, e.Line, e.Err)
+}
+
+func (e *SchemaFileError) Unwrap() error {
+	return e.Err
+}
+
+//LoadSchemaFiles returns a Schema which is loaded from files by Schema.LoadFile.
+func LoadSchemaFiles(files ...string) (*Schema, error) {
+	s := NewSchema()
+	for _, f := range files {
+		if err := s.LoadFile(f); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+//LoadFile adds the definitions in the file named name to s.
+//A file whose extension is ".ldif" is read by ReadLDIF, and other files are read by ReadOpenLDAPSchema.
+func (s *Schema) LoadFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if strings.EqualFold(filepath.Ext(name), ".ldif") {
+		return s.ReadLDIF(f, name)
+	}
+	return s.ReadOpenLDAPSchema(f, name)
+}
+
+//ReadOpenLDAPSchema adds the attribute types in an OpenLDAP .schema file read from r to s.
+//objectIdentifier macros are expanded, and objectClass, ldapSyntax and ditContentRule definitions are skipped.
+//filename is only used for error messages.
+func (s *Schema) ReadOpenLDAPSchema(r io.Reader, filename string) error {
+	macros := make(map[string]string)
+	return readSchemaStatements(r, filename, func(stmt string) error {
+		keyword, def := splitKeyword(stmt)
+		switch strings.ToLower(keyword) {
+		case "attributetype", "attributetypes":
+			return s.AddAttributeType(expandOIDMacros(def, macros))
+		case "objectidentifier":
+			return addOIDMacro(macros, def)
+		case "objectclass", "objectclasses", "ldapsyntax", "ditcontentrule":
+			return nil
+		default:
+			return fmt.Errorf("ldapstrprep: unknown schema directive %q", keyword)
+		}
+	})
+}
+
+//ReadLDIF adds the attribute types and the matching rules in a LDIF file read from r to s.
+//The file is an export of a subschema subentry (attributeTypes and matchingRules),
+//or an OpenLDAP cn=config schema entry (olcAttributeTypes and olcObjectIdentifier).
+//The file is read by the ldif package, and the attributes of modify change records which add or replace values,
+//as ldapmodify applies to cn=config, are read as well. Other attributes are skipped.
+//filename is only used for error messages.
+//https://tools.ietf.org/html/rfc2849
+func (s *Schema) ReadLDIF(r io.Reader, filename string) error {
+	recs, err := ldif.NewReader(r).ReadAll()
+	if err != nil {
+		var pe *ldif.ParseError
+		if errors.As(err, &pe) {
+			return &SchemaFileError{File: filename, Line: pe.Line, Err: pe.Err}
+		}
+		return &SchemaFileError{File: filename, Err: err}
+	}
+	macros := make(map[string]string)
+	for _, v := range ldifValues(recs) {
+		switch strings.ToLower(v.attr) {
+		case "attributetypes", "olcattributetypes":
+			err = s.AddAttributeType(expandOIDMacros(trimOrderingIndex(v.value), macros))
+		case "matchingrules":
+			err = s.AddMatchingRule(trimOrderingIndex(v.value))
+		case "olcobjectidentifier":
+			err = addOIDMacro(macros, trimOrderingIndex(v.value))
+		}
+		if err != nil {
+			return &SchemaFileError{File: filename, Line: v.line, Err: err}
+		}
+	}
+	return nil
+}
+
+//readSchemaStatements calls f for each statement of an OpenLDAP .schema file read from r.
+//A statement is continued by lines which start with white space. Empty lines and lines which start with # are skipped.
+func readSchemaStatements(r io.Reader, filename string, f func(stmt string) error) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	var stmt strings.Builder
+	start, n := 0, 0
+	flush := func() error {
+		if stmt.Len() == 0 {
+			return nil
+		}
+		defer stmt.Reset()
+		if err := f(stmt.String()); err != nil {
+			return &SchemaFileError{File: filename, Line: start, Err: err}
+		}
+		return nil
+	}
+	for sc.Scan() {
+		n++
+		line := sc.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.TrimSpace(line) == "" {
+			if err := flush(); err != nil {
+				return err
+			}
+			continue
+		}
+		if line[0] == ' ' || line[0] == '\t' {
+			if stmt.Len() == 0 {
+				return &SchemaFileError{File: filename, Line: n, Err: fmt.Errorf("ldapstrprep: continuation line without statement")}
+			}
+			stmt.WriteString(" ")
+			stmt.WriteString(strings.TrimSpace(line))
+			continue
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+		start = n
+		stmt.WriteString(strings.TrimSpace(line))
+	}
+	if err := sc.Err(); err != nil {
+		return &SchemaFileError{File: filename, Line: n, Err: err}
+	}
+	return flush()
+}
+
+//ldifValue is a value of an attribute of a LDIF record. line is the line number where the value starts.
+type ldifValue struct {
+	attr  string
+	value string
+	line  int
+}
+
+//ldifValues returns the values of the attributes of recs in the order of the lines. The values of content records,
+//add change records and the add and replace modifications of modify change records are returned.
+func ldifValues(recs []*ldif.Record) []ldifValue {
+	values := make([]ldifValue, 0, 0)
+	for _, rec := range recs {
+		for _, a := range rec.Attributes {
+			for i, v := range a.Values {
+				values = append(values, ldifValue{a.Type, v, a.Lines[i]})
+			}
+		}
+		for _, m := range rec.Modifications {
+			if m.Operation != ldif.ModAdd && m.Operation != ldif.ModReplace {
+				continue
+			}
+			for i, v := range m.Values {
+				values = append(values, ldifValue{m.Type, v, m.Lines[i]})
+			}
+		}
+	}
+	sort.SliceStable(values, func(i, j int) bool { return values[i].line < values[j].line })
+	return values
+}
+
+//splitKeyword splits stmt into its first word and the rest.
+func splitKeyword(stmt string) (keyword string, rest string) {
+	i := strings.IndexAny(stmt, " \t")
+	if i < 0 {
+		return stmt, ""
+	}
+	return stmt[:i], strings.TrimSpace(stmt[i+1:])
+}
+
+//trimOrderingIndex removes the X-ORDERED index, such as {0}, at the beginning of the cn=config value v.
+func trimOrderingIndex(v string) string {
+	if strings.HasPrefix(v, "{") {
+		if i := strings.IndexByte(v, '}'); i > 0 {
+			return v[i+1:]
+		}
+	}
+	return v
+}
+
+//addOIDMacro adds the objectIdentifier macro def, such as "OLcfgAt OLcfg:3", to macros.
+func addOIDMacro(macros map[string]string, def string) error {
+	fields := strings.Fields(def)
+	if len(fields) != 2 {
+		return fmt.Errorf("ldapstrprep: invalid objectIdentifier %q", def)
+	}
+	oid := expandOIDMacro(fields[1], macros)
+	if !isNumericOID(oid) {
+		return fmt.Errorf("ldapstrprep: invalid objectIdentifier %q", def)
+	}
+	macros[fields[0]] = oid
+	return nil
+}
+
+//expandOIDMacro expands w if w is a macro name, or a macro name followed by a colon and a suffix.
+func expandOIDMacro(w string, macros map[string]string) string {
+	name, suffix, hasSuffix := strings.Cut(w, ":")
+	oid, ok := macros[name]
+	switch {
+	case !ok:
+		return w
+	case hasSuffix:
+		return oid + "." + suffix
+	default:
+		return oid
+	}
+}
+
+//expandOIDMacros expands objectIdentifier macros in the unquoted words of the description def.
+func expandOIDMacros(def string, macros map[string]string) string {
+	if len(macros) == 0 {
+		return def
+	}
+	var b strings.Builder
+	for i := 0; i < len(def); {
+		c := def[i]
+		switch {
+		case c == '\'':
+			end := strings.IndexByte(def[i+1:], '\'')
+			if end < 0 {
+				b.WriteString(def[i:])
+				return b.String()
+			}
+			b.WriteString(def[i : i+end+2])
+			i += end + 2
+		case strings.IndexByte(" \t()", c) >= 0:
+			b.WriteByte(c)
+			i++
+		default:
+			start := i
+			for i < len(def) && strings.IndexByte(" \t()'", def[i]) < 0 {
+				i++
+			}
+			b.WriteString(expandOIDMacro(def[start:i], macros))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,164 @@
+package ldapstrprep
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testOpenLDAPSchema = `# core.schema excerpt
+objectIdentifier TestRoot 1.3.6.1.4.1.99999
+objectIdentifier TestAt TestRoot:1
+
+attributetype ( 2.5.4.41 NAME 'name'
+	EQUALITY caseIgnoreMatch
+	SUBSTR caseIgnoreSubstringsMatch
+	SYNTAX 1.3.6.1.4.1.1466.115.121.1.15{32768} )
+
+attributetype ( 2.5.4.3 NAME ( 'cn' 'commonName' )
+	DESC 'RFC4519: common name(s) for which the entity is known by'
+	SUP name )
+# a comment between statements
+objectclass ( 2.5.6.6 NAME 'person' SUP top STRUCTURAL
+	MUST ( sn $ cn ) )
+
+attributeType ( TestAt:1 NAME 'testExactName'
+	SUP name EQUALITY caseExactMatch )
+`
+
+const testSchemaLDIF = `version: 1
+# subschema subentry excerpt
+dn: cn=schema
+objectClass: top
+objectClass: subschema
+attributeTypes: ( 2.5.4.41 NAME 'name' EQUALITY caseIgnoreMatch SUBSTR caseIg
+ noreSubstringsMatch SYNTAX 1.3.6.1.4.1.1466.115.121.1.15{32768} )
+attributeTypes:: KCAyLjUuNC4zIE5BTUUgKCAnY24nICdjb21tb25OYW1lJyApIFNVUCBuYW1lICk=
+matchingRules: ( 1.3.6.1.4.1.99999.2.1 NAME 'testMatch' SYNTAX 1.3.6.1.4.1.1
+ 466.115.121.1.15 )
+
+dn: cn={0}test,cn=schema,cn=config
+objectClass: olcSchemaConfig
+olcObjectIdentifier: {0}TestAt 1.3.6.1.4.1.99999.1
+olcAttributeTypes: {0}( TestAt:1 NAME 'testExactName' SUP name EQUALITY 1.3.
+ 6.1.4.1.99999.2.1 )
+`
+
+func TestSchema_ReadOpenLDAPSchema(t *testing.T) {
+	s := NewSchema()
+	if err := s.ReadOpenLDAPSchema(strings.NewReader(testOpenLDAPSchema), "core.schema"); err != nil {
+		t.Fatalf("ReadOpenLDAPSchema() error = %v", err)
+	}
+	type args struct {
+		attr string
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{"TestCase:name", args{"name"}, "caseIgnoreMatch"},
+		{"TestCase:cn", args{"cn"}, "caseIgnoreMatch"},
+		{"TestCase:macro expanded OID", args{"1.3.6.1.4.1.99999.1.1"}, "caseExactMatch"},
+		{"TestCase:objectclass is skipped", args{"person"}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.EqualityRule(tt.args.attr); got != tt.want {
+				t.Errorf("EqualityRule() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchema_ReadLDIF(t *testing.T) {
+	s := NewSchema()
+	if err := s.ReadLDIF(strings.NewReader(testSchemaLDIF), "schema.ldif"); err != nil {
+		t.Fatalf("ReadLDIF() error = %v", err)
+	}
+	type args struct {
+		attr string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		want       string
+		wantSubstr string
+	}{
+		{"TestCase:folded line", args{"name"}, "caseIgnoreMatch", "caseIgnoreSubstringsMatch"},
+		{"TestCase:base64 value", args{"commonName"}, "caseIgnoreMatch", "caseIgnoreSubstringsMatch"},
+		{"TestCase:cn=config value", args{"testExactName"}, "testMatch", "caseIgnoreSubstringsMatch"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.EqualityRule(tt.args.attr); got != tt.want {
+				t.Errorf("EqualityRule() = %q, want %q", got, tt.want)
+			}
+			if got := s.SubstringsRule(tt.args.attr); got != tt.wantSubstr {
+				t.Errorf("SubstringsRule() = %q, want %q", got, tt.wantSubstr)
+			}
+		})
+	}
+}
+
+func TestSchemaFileError(t *testing.T) {
+	type args struct {
+		src  string
+		ldif bool
+	}
+	tests := []struct {
+		name     string
+		args     args
+		wantLine int
+	}{
+		{"TestCase:invalid attribute type", args{"# comment\n\nattributetype ( 2.5.4.3 NAME 'cn'\n\tSUP name\n\tFOO )\n", false}, 3},
+		{"TestCase:unknown directive", args{"attributetype ( 2.5.4.3 NAME 'cn' SUP name )\nmatchingrule ( 2.5.13.2 )\n", false}, 2},
+		{"TestCase:leading continuation line", args{"\tSUP name )\n", false}, 1},
+		{"TestCase:undefined macro", args{"attributetype ( Undefined:1 NAME 'cn' SUP name )\n", false}, 1},
+		{"TestCase:invalid LDIF attribute type", args{"dn: cn=schema\nattributeTypes: ( 2.5.4.3 NAME 'cn'\n  SUP name\n  FOO )\n", true}, 2},
+		{"TestCase:invalid base64", args{"# comment\ndn: cn=schema\nattributeTypes:: !!!\n", true}, 3},
+		{"TestCase:unsupported URL scheme", args{"dn: cn=schema\nattributeTypes:< http://example.com/schema\n", true}, 2},
+		{"TestCase:invalid modification", args{"dn: cn={0}test,cn=schema,cn=config\nchangetype: modify\nadd: olcAttributeTypes\nolcAttributeTypes: ( 2.5.4.3 NAME 'cn' FOO )\n-\n", true}, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			if tt.args.ldif {
+				err = NewSchema().ReadLDIF(strings.NewReader(tt.args.src), "test.ldif")
+			} else {
+				err = NewSchema().ReadOpenLDAPSchema(strings.NewReader(tt.args.src), "test.schema")
+			}
+			var fileErr *SchemaFileError
+			if !errors.As(err, &fileErr) {
+				t.Fatalf("error = %v, want *SchemaFileError", err)
+			}
+			if fileErr.Line != tt.wantLine {
+				t.Errorf("SchemaFileError.Line = %d, want %d (%v)", fileErr.Line, tt.wantLine, err)
+			}
+		})
+	}
+}
+
+func TestLoadSchemaFiles(t *testing.T) {
+	dir := t.TempDir()
+	schemaFile := filepath.Join(dir, "core.schema")
+	ldifFile := filepath.Join(dir, "schema.LDIF")
+	if err := os.WriteFile(schemaFile, []byte(testOpenLDAPSchema), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(ldifFile, []byte(testSchemaLDIF), 0600); err != nil {
+		t.Fatal(err)
+	}
+	s, err := LoadSchemaFiles(schemaFile, ldifFile)
+	if err != nil {
+		t.Fatalf("LoadSchemaFiles() error = %v", err)
+	}
+	if got := s.EqualityRule("testExactName"); got != "testMatch" {
+		t.Errorf("EqualityRule() = %q, want %q", got, "testMatch")
+	}
+	if _, err := LoadSchemaFiles(filepath.Join(dir, "missing.schema")); err == nil {
+		t.Errorf("LoadSchemaFiles() error = nil, want error")
+	}
+}
@@ -0,0 +1,233 @@
+//Package stringprep implements the string preparation engine described in RFC 3454 (Preparation of Internationalized Strings ("stringprep")).
+/*
+A profile of stringprep is expressed as a Profile, whose mapping, prohibited and bidirectional tables are data.
+The tables of RFC 3454 are provided as TableA1, TableB2, TableC3 and so on, so that profiles can share them.
+
+The steps of the preparation are following:
+
+1)  Map:
+
+  func (p *Profile) Map(src []rune) []rune
+
+2)  Normalize:
+
+  func (p *Profile) Normalize(src []rune) []rune
+
+3)  Prohibit:
+
+  func (p *Profile) CheckProhibited(src []rune) error
+
+4)  Check bidi:
+
+  func (p *Profile) CheckBidi(src []rune) error
+
+Prepare applies them in order.
+*/
+package stringprep
+
+import (
+	"fmt"
+	"sort"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+//Range is the range of code points from Lo to Hi, inclusive.
+type Range struct {
+	Lo rune
+	Hi rune
+}
+
+//Set is a table of code points, whose ranges are sorted and do not overlap.
+type Set []Range
+
+//Contains reports whether c is in s.
+func (s Set) Contains(c rune) bool {
+	i := sort.Search(len(s), func(i int) bool { return s[i].Hi >= c })
+	return i < len(s) && s[i].Lo <= c
+}
+
+//Mapping is a mapping table, which maps a code point to the code points of the value.
+//A code point whose value is empty is mapped to nothing.
+//https://tools.ietf.org/html/rfc3454#section-3
+type Mapping map[rune][]rune
+
+//NewMapping returns the Mapping which maps the code points in s to v, such as the non-ASCII spaces to SPACE.
+func NewMapping(s Set, v []rune) Mapping {
+	m := make(Mapping)
+	for _, r := range s {
+		for c := r.Lo; c <= r.Hi; c++ {
+			m[c] = v
+		}
+	}
+	return m
+}
+
+//Profile is a profile of stringprep.
+//https://tools.ietf.org/html/rfc3454#section-2
+type Profile struct {
+	//Mappings are the mapping tables. A code point is mapped by the first table which has it,
+	//and the code points which no table has are kept.
+	Mappings []Mapping
+	//NFKC reports whether strings are normalized to Unicode Form KC.
+	NFKC bool
+	//Prohibited are the tables of the prohibited code points.
+	Prohibited []Set
+	//Unassigned is the table of the unassigned code points, such as TableA1.
+	//They are prohibited unless AllowUnassigned is true, which is for queries.
+	//https://tools.ietf.org/html/rfc3454#section-7
+	Unassigned      Set
+	AllowUnassigned bool
+	//RandAL and L are the tables of the characters with the bidirectional property R or AL, and L.
+	//Bidirectional characters are checked if RandAL is not nil.
+	//https://tools.ietf.org/html/rfc3454#section-6
+	RandAL Set
+	L      Set
+}
+
+//ProhibitedError is the error which reports that a string has a prohibited or unassigned code point.
+type ProhibitedError struct {
+	Rune       rune
+	Unassigned bool
+}
+
+func (e *ProhibitedError) Error() string {
+	if e.Unassigned {
+		return fmt.Sprintf("stringprep: %#U is unassigned", e.Rune)
+	}
+	return fmt.Sprintf("stringprep: %#U is prohibited", e.Rune)
+}
+
+//BidiError is the error which reports that a string does not satisfy the requirements of bidirectional characters.
+//https://tools.ietf.org/html/rfc3454#section-6
+type BidiError struct {
+	Reason string
+}
+
+func (e *BidiError) Error() string {
+	return fmt.Sprintf("stringprep: %s", e.Reason)
+}
+
+//Prepare prepares src with p, which maps, normalizes, and checks prohibited and bidirectional characters.
+//https://tools.ietf.org/html/rfc3454#section-2
+func (p *Profile) Prepare(src []rune) ([]rune, error) {
+	dst := p.Normalize(p.Map(src))
+	if err := p.CheckProhibited(dst); err != nil {
+		return nil, err
+	}
+	if err := p.CheckBidi(dst); err != nil {
+		return nil, err
+	}
+	return dst, nil
+}
+
+//PrepareString prepares s with p like Prepare.
+func (p *Profile) PrepareString(s string) (string, error) {
+	dst, err := p.Prepare([]rune(s))
+	if err != nil {
+		return "", err
+	}
+	return string(dst), nil
+}
+
+//Map maps src with the mapping tables of p.
+//https://tools.ietf.org/html/rfc3454#section-3
+func (p *Profile) Map(src []rune) []rune {
+	dst := make([]rune, 0, len(src))
+	for _, c := range src {
+		dst = append(dst, p.mapCharacter(c)...)
+	}
+	return dst
+}
+
+//mapCharacter returns the code points which c is mapped to.
+func (p *Profile) mapCharacter(c rune) []rune {
+	for _, m := range p.Mappings {
+		if v, ok := m[c]; ok {
+			return v
+		}
+	}
+	return []rune{c}
+}
+
+//Normalize normalizes src to Unicode Form KC if p.NFKC is true. Otherwise src is returned as it is.
+//The code points in p.Unassigned are kept as they are, as Unicode 3.2, which RFC 3454 is based on, keeps them.
+//Later versions of Unicode may map them to assigned code points, which would get them past CheckProhibited.
+//https://tools.ietf.org/html/rfc3454#section-4
+func (p *Profile) Normalize(src []rune) []rune {
+	if !p.NFKC {
+		return src
+	}
+	dst := make([]rune, 0, len(src))
+	start := 0
+	for i, c := range src {
+		if p.Unassigned.Contains(c) {
+			dst = append(appendNFKC(dst, src[start:i]), c)
+			start = i + 1
+		}
+	}
+	return appendNFKC(dst, src[start:])
+}
+
+//appendNFKC appends src normalized to Unicode Form KC to dst.
+func appendNFKC(dst []rune, src []rune) []rune {
+	s := string(src)
+	if norm.NFKC.IsNormalString(s) {
+		return append(dst, src...)
+	}
+	return append(dst, []rune(norm.NFKC.String(s))...)
+}
+
+//CheckProhibited returns a ProhibitedError if src has a prohibited code point, or an unassigned code point
+//which is not allowed.
+//https://tools.ietf.org/html/rfc3454#section-5
+func (p *Profile) CheckProhibited(src []rune) error {
+	for _, c := range src {
+		if err := p.checkProhibitedCharacter(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//checkProhibitedCharacter returns a ProhibitedError if c is not allowed.
+func (p *Profile) checkProhibitedCharacter(c rune) error {
+	for _, s := range p.Prohibited {
+		if s.Contains(c) {
+			return &ProhibitedError{Rune: c}
+		}
+	}
+	if !p.AllowUnassigned && p.Unassigned.Contains(c) {
+		return &ProhibitedError{Rune: c, Unassigned: true}
+	}
+	return nil
+}
+
+//CheckBidi returns a BidiError if src has a character in p.RandAL and
+//has a character in p.L, or does not start and end with characters in p.RandAL.
+//Nothing is checked if p.RandAL is nil.
+//https://tools.ietf.org/html/rfc3454#section-6
+func (p *Profile) CheckBidi(src []rune) error {
+	if p.RandAL == nil {
+		return nil
+	}
+	hasRandAL := false
+	for _, c := range src {
+		if p.RandAL.Contains(c) {
+			hasRandAL = true
+			break
+		}
+	}
+	if !hasRandAL {
+		return nil
+	}
+	for _, c := range src {
+		if p.L.Contains(c) {
+			return &BidiError{Reason: fmt.Sprintf("%#U is left-to-right in a right-to-left string", c)}
+		}
+	}
+	if !p.RandAL.Contains(src[0]) || !p.RandAL.Contains(src[len(src)-1]) {
+		return &BidiError{Reason: "right-to-left string does not start and end with right-to-left characters"}
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package stringprep
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+//testProfile is a profile which uses the tables of RFC 3454, with the bidirectional tables for Hebrew and Latin letters.
+var testProfile = &Profile{
+	Mappings:   []Mapping{{0X00AD: {}, 0X00A0: {0X0020}}, TableB2},
+	NFKC:       true,
+	Prohibited: []Set{TableC3, TableC4, TableC5, TableC8},
+	Unassigned: TableA1,
+	RandAL:     Set{{0X05D0, 0X05EA}},
+	L:          Set{{0X0041, 0X005A}, {0X0061, 0X007A}},
+}
+
+func TestSet_Contains(t *testing.T) {
+	type args struct {
+		c rune
+	}
+	s := Set{{0X0041, 0X0041}, {0X0061, 0X007A}, {0XE000, 0XF8FF}}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"TestCase:single", args{0X0041}, true},
+		{"TestCase:before single", args{0X0040}, false},
+		{"TestCase:after single", args{0X0042}, false},
+		{"TestCase:range start", args{0X0061}, true},
+		{"TestCase:range end", args{0X007A}, true},
+		{"TestCase:between ranges", args{0X0100}, false},
+		{"TestCase:last range", args{0XF000}, true},
+		{"TestCase:after last range", args{0XF900}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := s.Contains(tt.args.c); got != tt.want {
+				t.Errorf("Set.Contains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTables(t *testing.T) {
+	tests := []struct {
+		name string
+		set  Set
+	}{
+		{"TestCase:A.1", TableA1},
+		{"TestCase:C.1.1", TableC11},
+		{"TestCase:C.1.2", TableC12},
+		{"TestCase:C.2.1", TableC21},
+		{"TestCase:C.2.2", TableC22},
+		{"TestCase:C.3", TableC3},
+		{"TestCase:C.4", TableC4},
+		{"TestCase:C.5", TableC5},
+		{"TestCase:C.6", TableC6},
+		{"TestCase:C.7", TableC7},
+		{"TestCase:C.8", TableC8},
+		{"TestCase:C.9", TableC9},
+		{"TestCase:D.1", TableD1},
+		{"TestCase:D.2", TableD2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, r := range tt.set {
+				if r.Lo > r.Hi {
+					t.Errorf("range %d: %U > %U", i, r.Lo, r.Hi)
+				}
+				if i > 0 && r.Lo <= tt.set[i-1].Hi {
+					t.Errorf("range %d: %U overlaps or is not sorted", i, r.Lo)
+				}
+			}
+		})
+	}
+}
+
+func TestNewMapping(t *testing.T) {
+	type args struct {
+		s Set
+		v []rune
+	}
+	tests := []struct {
+		name string
+		args args
+		want Mapping
+	}{
+		{"TestCase:to space", args{Set{{0X00A0, 0X00A0}, {0X2000, 0X2002}}, []rune{0X0020}}, Mapping{0X00A0: {0X0020}, 0X2000: {0X0020}, 0X2001: {0X0020}, 0X2002: {0X0020}}},
+		{"TestCase:to nothing", args{Set{{0X00AD, 0X00AD}}, []rune{}}, Mapping{0X00AD: {}}},
+		{"TestCase:empty", args{Set{}, []rune{}}, Mapping{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewMapping(tt.args.s, tt.args.v); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewMapping() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfile_Map(t *testing.T) {
+	type args struct {
+		src []rune
+	}
+	tests := []struct {
+		name string
+		args args
+		want []rune
+	}{
+		{"TestCase:blank", args{[]rune("")}, []rune{}},
+		{"TestCase:not mapped", args{[]rune("abc")}, []rune("abc")},
+		{"TestCase:mapped to nothing", args{[]rune("a\U000000ADb")}, []rune("ab")},
+		{"TestCase:mapped to space", args{[]rune("a\U000000A0b")}, []rune("a b")},
+		{"TestCase:case folding", args{[]rune("ABC")}, []rune("abc")},
+		{"TestCase:case folding to multiple code points", args{[]rune("\U000000DF")}, []rune("ss")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := testProfile.Map(tt.args.src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Profile.Map() = %U, want %U", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfile_Normalize(t *testing.T) {
+	type args struct {
+		p   *Profile
+		src []rune
+	}
+	tests := []struct {
+		name string
+		args args
+		want []rune
+	}{
+		{"TestCase:NFKC", args{testProfile, []rune("\U0000FF21\U0000212B")}, []rune("A\U000000C5")},
+		{"TestCase:no normalization", args{&Profile{}, []rune("\U0000FF21")}, []rune("\U0000FF21")},
+		{"TestCase:unassigned", args{testProfile, []rune("\U0000FF21\U00001D3E\U0000FF21")}, []rune("A\U00001D3EA")},
+		{"TestCase:unassigned followed by combining mark", args{testProfile, []rune("\U00001D3E\U00000327\U00000301")}, []rune("\U00001D3E\U00000327\U00000301")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.args.p.Normalize(tt.args.src); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Profile.Normalize() = %U, want %U", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfile_CheckProhibited(t *testing.T) {
+	type args struct {
+		p   *Profile
+		src []rune
+	}
+	query := *testProfile
+	query.AllowUnassigned = true
+	tests := []struct {
+		name    string
+		args    args
+		wantErr *ProhibitedError
+	}{
+		{"TestCase:allowed", args{testProfile, []rune("abc")}, nil},
+		{"TestCase:private use", args{testProfile, []rune("a\U0000E000")}, &ProhibitedError{Rune: 0XE000}},
+		{"TestCase:non-character", args{testProfile, []rune("\U0000FFFF")}, &ProhibitedError{Rune: 0XFFFF}},
+		{"TestCase:change display properties", args{testProfile, []rune("\U0000200E")}, &ProhibitedError{Rune: 0X200E}},
+		{"TestCase:unassigned", args{testProfile, []rune("\U00000221")}, &ProhibitedError{Rune: 0X0221, Unassigned: true}},
+		{"TestCase:unassigned in query", args{&query, []rune("\U00000221")}, nil},
+		{"TestCase:prohibited in query", args{&query, []rune("\U0000E000")}, &ProhibitedError{Rune: 0XE000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.p.CheckProhibited(tt.args.src)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("Profile.CheckProhibited() error = %v, want nil", err)
+				}
+				return
+			}
+			var e *ProhibitedError
+			if !errors.As(err, &e) || !reflect.DeepEqual(e, tt.wantErr) {
+				t.Errorf("Profile.CheckProhibited() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProfile_CheckBidi(t *testing.T) {
+	type args struct {
+		p   *Profile
+		src []rune
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantErr bool
+	}{
+		{"TestCase:left-to-right", args{testProfile, []rune("abc")}, false},
+		{"TestCase:right-to-left", args{testProfile, []rune("\U000005D0\U000005D1")}, false},
+		{"TestCase:right-to-left with digits inside", args{testProfile, []rune("\U000005D01\U000005D1")}, false},
+		{"TestCase:mixed", args{testProfile, []rune("\U000005D0a\U000005D1")}, true},
+		{"TestCase:right-to-left ends with digit", args{testProfile, []rune("\U000005D01")}, true},
+		{"TestCase:right-to-left starts with digit", args{testProfile, []rune("1\U000005D0")}, true},
+		{"TestCase:blank", args{testProfile, []rune("")}, false},
+		{"TestCase:no bidi tables", args{&Profile{}, []rune("\U000005D0a")}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.args.p.CheckBidi(tt.args.src)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Profile.CheckBidi() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			var e *BidiError
+			if err != nil && !errors.As(err, &e) {
+				t.Errorf("Profile.CheckBidi() error = %v, want BidiError", err)
+			}
+		})
+	}
+}
+
+func TestProfile_PrepareString(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{"TestCase:mapped and normalized", args{"\U0000FF21\U000000ADB\U000000A0C"}, "ab c", false},
+		{"TestCase:prohibited after normalization", args{"a\U0000E000"}, "", true},
+		{"TestCase:bidi", args{"\U000005D0A"}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := testProfile.PrepareString(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Profile.PrepareString() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Profile.PrepareString() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
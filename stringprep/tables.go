@@ -0,0 +1,2338 @@
+package stringprep
+
+//TableA1 is the unassigned code points in Unicode 3.2.
+//https://tools.ietf.org/html/rfc3454#appendix-A.1
+var TableA1 = Set{
+	{0X0221, 0X0221},
+	{0X0234, 0X024F},
+	{0X02AE, 0X02AF},
+	{0X02EF, 0X02FF},
+	{0X0350, 0X035F},
+	{0X0370, 0X0373},
+	{0X0376, 0X0379},
+	{0X037B, 0X037D},
+	{0X037F, 0X0383},
+	{0X038B, 0X038B},
+	{0X038D, 0X038D},
+	{0X03A2, 0X03A2},
+	{0X03CF, 0X03CF},
+	{0X03F7, 0X03FF},
+	{0X0487, 0X0487},
+	{0X04CF, 0X04CF},
+	{0X04F6, 0X04F7},
+	{0X04FA, 0X04FF},
+	{0X0510, 0X0530},
+	{0X0557, 0X0558},
+	{0X0560, 0X0560},
+	{0X0588, 0X0588},
+	{0X058B, 0X0590},
+	{0X05A2, 0X05A2},
+	{0X05BA, 0X05BA},
+	{0X05C5, 0X05CF},
+	{0X05EB, 0X05EF},
+	{0X05F5, 0X060B},
+	{0X060D, 0X061A},
+	{0X061C, 0X061E},
+	{0X0620, 0X0620},
+	{0X063B, 0X063F},
+	{0X0656, 0X065F},
+	{0X06EE, 0X06EF},
+	{0X06FF, 0X06FF},
+	{0X070E, 0X070E},
+	{0X072D, 0X072F},
+	{0X074B, 0X077F},
+	{0X07B2, 0X0900},
+	{0X0904, 0X0904},
+	{0X093A, 0X093B},
+	{0X094E, 0X094F},
+	{0X0955, 0X0957},
+	{0X0971, 0X0980},
+	{0X0984, 0X0984},
+	{0X098D, 0X098E},
+	{0X0991, 0X0992},
+	{0X09A9, 0X09A9},
+	{0X09B1, 0X09B1},
+	{0X09B3, 0X09B5},
+	{0X09BA, 0X09BB},
+	{0X09BD, 0X09BD},
+	{0X09C5, 0X09C6},
+	{0X09C9, 0X09CA},
+	{0X09CE, 0X09D6},
+	{0X09D8, 0X09DB},
+	{0X09DE, 0X09DE},
+	{0X09E4, 0X09E5},
+	{0X09FB, 0X0A01},
+	{0X0A03, 0X0A04},
+	{0X0A0B, 0X0A0E},
+	{0X0A11, 0X0A12},
+	{0X0A29, 0X0A29},
+	{0X0A31, 0X0A31},
+	{0X0A34, 0X0A34},
+	{0X0A37, 0X0A37},
+	{0X0A3A, 0X0A3B},
+	{0X0A3D, 0X0A3D},
+	{0X0A43, 0X0A46},
+	{0X0A49, 0X0A4A},
+	{0X0A4E, 0X0A58},
+	{0X0A5D, 0X0A5D},
+	{0X0A5F, 0X0A65},
+	{0X0A75, 0X0A80},
+	{0X0A84, 0X0A84},
+	{0X0A8C, 0X0A8C},
+	{0X0A8E, 0X0A8E},
+	{0X0A92, 0X0A92},
+	{0X0AA9, 0X0AA9},
+	{0X0AB1, 0X0AB1},
+	{0X0AB4, 0X0AB4},
+	{0X0ABA, 0X0ABB},
+	{0X0AC6, 0X0AC6},
+	{0X0ACA, 0X0ACA},
+	{0X0ACE, 0X0ACF},
+	{0X0AD1, 0X0ADF},
+	{0X0AE1, 0X0AE5},
+	{0X0AF0, 0X0B00},
+	{0X0B04, 0X0B04},
+	{0X0B0D, 0X0B0E},
+	{0X0B11, 0X0B12},
+	{0X0B29, 0X0B29},
+	{0X0B31, 0X0B31},
+	{0X0B34, 0X0B35},
+	{0X0B3A, 0X0B3B},
+	{0X0B44, 0X0B46},
+	{0X0B49, 0X0B4A},
+	{0X0B4E, 0X0B55},
+	{0X0B58, 0X0B5B},
+	{0X0B5E, 0X0B5E},
+	{0X0B62, 0X0B65},
+	{0X0B71, 0X0B81},
+	{0X0B84, 0X0B84},
+	{0X0B8B, 0X0B8D},
+	{0X0B91, 0X0B91},
+	{0X0B96, 0X0B98},
+	{0X0B9B, 0X0B9B},
+	{0X0B9D, 0X0B9D},
+	{0X0BA0, 0X0BA2},
+	{0X0BA5, 0X0BA7},
+	{0X0BAB, 0X0BAD},
+	{0X0BB6, 0X0BB6},
+	{0X0BBA, 0X0BBD},
+	{0X0BC3, 0X0BC5},
+	{0X0BC9, 0X0BC9},
+	{0X0BCE, 0X0BD6},
+	{0X0BD8, 0X0BE6},
+	{0X0BF3, 0X0C00},
+	{0X0C04, 0X0C04},
+	{0X0C0D, 0X0C0D},
+	{0X0C11, 0X0C11},
+	{0X0C29, 0X0C29},
+	{0X0C34, 0X0C34},
+	{0X0C3A, 0X0C3D},
+	{0X0C45, 0X0C45},
+	{0X0C49, 0X0C49},
+	{0X0C4E, 0X0C54},
+	{0X0C57, 0X0C5F},
+	{0X0C62, 0X0C65},
+	{0X0C70, 0X0C81},
+	{0X0C84, 0X0C84},
+	{0X0C8D, 0X0C8D},
+	{0X0C91, 0X0C91},
+	{0X0CA9, 0X0CA9},
+	{0X0CB4, 0X0CB4},
+	{0X0CBA, 0X0CBD},
+	{0X0CC5, 0X0CC5},
+	{0X0CC9, 0X0CC9},
+	{0X0CCE, 0X0CD4},
+	{0X0CD7, 0X0CDD},
+	{0X0CDF, 0X0CDF},
+	{0X0CE2, 0X0CE5},
+	{0X0CF0, 0X0D01},
+	{0X0D04, 0X0D04},
+	{0X0D0D, 0X0D0D},
+	{0X0D11, 0X0D11},
+	{0X0D29, 0X0D29},
+	{0X0D3A, 0X0D3D},
+	{0X0D44, 0X0D45},
+	{0X0D49, 0X0D49},
+	{0X0D4E, 0X0D56},
+	{0X0D58, 0X0D5F},
+	{0X0D62, 0X0D65},
+	{0X0D70, 0X0D81},
+	{0X0D84, 0X0D84},
+	{0X0D97, 0X0D99},
+	{0X0DB2, 0X0DB2},
+	{0X0DBC, 0X0DBC},
+	{0X0DBE, 0X0DBF},
+	{0X0DC7, 0X0DC9},
+	{0X0DCB, 0X0DCE},
+	{0X0DD5, 0X0DD5},
+	{0X0DD7, 0X0DD7},
+	{0X0DE0, 0X0DF1},
+	{0X0DF5, 0X0E00},
+	{0X0E3B, 0X0E3E},
+	{0X0E5C, 0X0E80},
+	{0X0E83, 0X0E83},
+	{0X0E85, 0X0E86},
+	{0X0E89, 0X0E89},
+	{0X0E8B, 0X0E8C},
+	{0X0E8E, 0X0E93},
+	{0X0E98, 0X0E98},
+	{0X0EA0, 0X0EA0},
+	{0X0EA4, 0X0EA4},
+	{0X0EA6, 0X0EA6},
+	{0X0EA8, 0X0EA9},
+	{0X0EAC, 0X0EAC},
+	{0X0EBA, 0X0EBA},
+	{0X0EBE, 0X0EBF},
+	{0X0EC5, 0X0EC5},
+	{0X0EC7, 0X0EC7},
+	{0X0ECE, 0X0ECF},
+	{0X0EDA, 0X0EDB},
+	{0X0EDE, 0X0EFF},
+	{0X0F48, 0X0F48},
+	{0X0F6B, 0X0F70},
+	{0X0F8C, 0X0F8F},
+	{0X0F98, 0X0F98},
+	{0X0FBD, 0X0FBD},
+	{0X0FCD, 0X0FCE},
+	{0X0FD0, 0XFFF},
+	{0X1022, 0X1022},
+	{0X1028, 0X1028},
+	{0X102B, 0X102B},
+	{0X1033, 0X1035},
+	{0X103A, 0X103F},
+	{0X105A, 0X109F},
+	{0X10C6, 0X10CF},
+	{0X10F9, 0X10FA},
+	{0X10FC, 0X10FF},
+	{0X115A, 0X115E},
+	{0X11A3, 0X11A7},
+	{0X11FA, 0X11FF},
+	{0X1207, 0X1207},
+	{0X1247, 0X1247},
+	{0X1249, 0X1249},
+	{0X124E, 0X124F},
+	{0X1257, 0X1257},
+	{0X1259, 0X1259},
+	{0X125E, 0X125F},
+	{0X1287, 0X1287},
+	{0X1289, 0X1289},
+	{0X128E, 0X128F},
+	{0X12AF, 0X12AF},
+	{0X12B1, 0X12B1},
+	{0X12B6, 0X12B7},
+	{0X12BF, 0X12BF},
+	{0X12C1, 0X12C1},
+	{0X12C6, 0X12C7},
+	{0X12CF, 0X12CF},
+	{0X12D7, 0X12D7},
+	{0X12EF, 0X12EF},
+	{0X130F, 0X130F},
+	{0X1311, 0X1311},
+	{0X1316, 0X1317},
+	{0X131F, 0X131F},
+	{0X1347, 0X1347},
+	{0X135B, 0X1360},
+	{0X137D, 0X139F},
+	{0X13F5, 0X1400},
+	{0X1677, 0X167F},
+	{0X169D, 0X169F},
+	{0X16F1, 0X16FF},
+	{0X170D, 0X170D},
+	{0X1715, 0X171F},
+	{0X1737, 0X173F},
+	{0X1754, 0X175F},
+	{0X176D, 0X176D},
+	{0X1771, 0X1771},
+	{0X1774, 0X177F},
+	{0X17DD, 0X17DF},
+	{0X17EA, 0X17FF},
+	{0X180F, 0X180F},
+	{0X181A, 0X181F},
+	{0X1878, 0X187F},
+	{0X18AA, 0X1DFF},
+	{0X1E9C, 0X1E9F},
+	{0X1EFA, 0X1EFF},
+	{0X1F16, 0X1F17},
+	{0X1F1E, 0X1F1F},
+	{0X1F46, 0X1F47},
+	{0X1F4E, 0X1F4F},
+	{0X1F58, 0X1F58},
+	{0X1F5A, 0X1F5A},
+	{0X1F5C, 0X1F5C},
+	{0X1F5E, 0X1F5E},
+	{0X1F7E, 0X1F7F},
+	{0X1FB5, 0X1FB5},
+	{0X1FC5, 0X1FC5},
+	{0X1FD4, 0X1FD5},
+	{0X1FDC, 0X1FDC},
+	{0X1FF0, 0X1FF1},
+	{0X1FF5, 0X1FF5},
+	{0X1FFF, 0X1FFF},
+	{0X2053, 0X2056},
+	{0X2058, 0X205E},
+	{0X2064, 0X2069},
+	{0X2072, 0X2073},
+	{0X208F, 0X209F},
+	{0X20B2, 0X20CF},
+	{0X20EB, 0X20FF},
+	{0X213B, 0X213C},
+	{0X214C, 0X2152},
+	{0X2184, 0X218F},
+	{0X23CF, 0X23FF},
+	{0X2427, 0X243F},
+	{0X244B, 0X245F},
+	{0X24FF, 0X24FF},
+	{0X2614, 0X2615},
+	{0X2618, 0X2618},
+	{0X267E, 0X267F},
+	{0X268A, 0X2700},
+	{0X2705, 0X2705},
+	{0X270A, 0X270B},
+	{0X2728, 0X2728},
+	{0X274C, 0X274C},
+	{0X274E, 0X274E},
+	{0X2753, 0X2755},
+	{0X2757, 0X2757},
+	{0X275F, 0X2760},
+	{0X2795, 0X2797},
+	{0X27B0, 0X27B0},
+	{0X27BF, 0X27CF},
+	{0X27EC, 0X27EF},
+	{0X2B00, 0X2E7F},
+	{0X2E9A, 0X2E9A},
+	{0X2EF4, 0X2EFF},
+	{0X2FD6, 0X2FEF},
+	{0X2FFC, 0X2FFF},
+	{0X3040, 0X3040},
+	{0X3097, 0X3098},
+	{0X3100, 0X3104},
+	{0X312D, 0X3130},
+	{0X318F, 0X318F},
+	{0X31B8, 0X31EF},
+	{0X321D, 0X321F},
+	{0X3244, 0X3250},
+	{0X327C, 0X327E},
+	{0X32CC, 0X32CF},
+	{0X32FF, 0X32FF},
+	{0X3377, 0X337A},
+	{0X33DE, 0X33DF},
+	{0X33FF, 0X33FF},
+	{0X4DB6, 0X4DFF},
+	{0X9FA6, 0X9FFF},
+	{0XA48D, 0XA48F},
+	{0XA4C7, 0XABFF},
+	{0XD7A4, 0XD7FF},
+	{0XFA2E, 0XFA2F},
+	{0XFA6B, 0XFAFF},
+	{0XFB07, 0XFB12},
+	{0XFB18, 0XFB1C},
+	{0XFB37, 0XFB37},
+	{0XFB3D, 0XFB3D},
+	{0XFB3F, 0XFB3F},
+	{0XFB42, 0XFB42},
+	{0XFB45, 0XFB45},
+	{0XFBB2, 0XFBD2},
+	{0XFD40, 0XFD4F},
+	{0XFD90, 0XFD91},
+	{0XFDC8, 0XFDCF},
+	{0XFDFD, 0XFDFF},
+	{0XFE10, 0XFE1F},
+	{0XFE24, 0XFE2F},
+	{0XFE47, 0XFE48},
+	{0XFE53, 0XFE53},
+	{0XFE67, 0XFE67},
+	{0XFE6C, 0XFE6F},
+	{0XFE75, 0XFE75},
+	{0XFEFD, 0XFEFE},
+	{0XFF00, 0XFF00},
+	{0XFFBF, 0XFFC1},
+	{0XFFC8, 0XFFC9},
+	{0XFFD0, 0XFFD1},
+	{0XFFD8, 0XFFD9},
+	{0XFFDD, 0XFFDF},
+	{0XFFE7, 0XFFE7},
+	{0XFFEF, 0XFFF8},
+	{0X10000, 0X102FF},
+	{0X1031F, 0X1031F},
+	{0X10324, 0X1032F},
+	{0X1034B, 0X103FF},
+	{0X10426, 0X10427},
+	{0X1044E, 0X1CFFF},
+	{0X1D0F6, 0X1D0FF},
+	{0X1D127, 0X1D129},
+	{0X1D1DE, 0X1D3FF},
+	{0X1D455, 0X1D455},
+	{0X1D49D, 0X1D49D},
+	{0X1D4A0, 0X1D4A1},
+	{0X1D4A3, 0X1D4A4},
+	{0X1D4A7, 0X1D4A8},
+	{0X1D4AD, 0X1D4AD},
+	{0X1D4BA, 0X1D4BA},
+	{0X1D4BC, 0X1D4BC},
+	{0X1D4C1, 0X1D4C1},
+	{0X1D4C4, 0X1D4C4},
+	{0X1D506, 0X1D506},
+	{0X1D50B, 0X1D50C},
+	{0X1D515, 0X1D515},
+	{0X1D51D, 0X1D51D},
+	{0X1D53A, 0X1D53A},
+	{0X1D53F, 0X1D53F},
+	{0X1D545, 0X1D545},
+	{0X1D547, 0X1D549},
+	{0X1D551, 0X1D551},
+	{0X1D6A4, 0X1D6A7},
+	{0X1D7CA, 0X1D7CD},
+	{0X1D800, 0X1FFFD},
+	{0X2A6D7, 0X2F7FF},
+	{0X2FA1E, 0X2FFFD},
+	{0X30000, 0X3FFFD},
+	{0X40000, 0X4FFFD},
+	{0X50000, 0X5FFFD},
+	{0X60000, 0X6FFFD},
+	{0X70000, 0X7FFFD},
+	{0X80000, 0X8FFFD},
+	{0X90000, 0X9FFFD},
+	{0XA0000, 0XAFFFD},
+	{0XB0000, 0XBFFFD},
+	{0XC0000, 0XCFFFD},
+	{0XD0000, 0XDFFFD},
+	{0XE0000, 0XE0000},
+	{0XE0002, 0XE001F},
+	{0XE0080, 0XEFFFD},
+}
+
+//TableB1 is the commonly mapped to nothing code points.
+//https://tools.ietf.org/html/rfc3454#appendix-B.1
+var TableB1 = Mapping{
+	0X00AD: {},
+	0X034F: {},
+	0X1806: {},
+	0X180B: {},
+	0X180C: {},
+	0X180D: {},
+	0X200B: {},
+	0X200C: {},
+	0X200D: {},
+	0X2060: {},
+	0XFE00: {},
+	0XFE01: {},
+	0XFE02: {},
+	0XFE03: {},
+	0XFE04: {},
+	0XFE05: {},
+	0XFE06: {},
+	0XFE07: {},
+	0XFE08: {},
+	0XFE09: {},
+	0XFE0A: {},
+	0XFE0B: {},
+	0XFE0C: {},
+	0XFE0D: {},
+	0XFE0E: {},
+	0XFE0F: {},
+	0XFEFF: {},
+}
+
+//TableB2 is the mapping for case-folding used with NFKC.
+//https://tools.ietf.org/html/rfc3454#appendix-B.2
+var TableB2 = Mapping{
+	0X0041:  {0X0061},
+	0X0042:  {0X0062},
+	0X0043:  {0X0063},
+	0X0044:  {0X0064},
+	0X0045:  {0X0065},
+	0X0046:  {0X0066},
+	0X0047:  {0X0067},
+	0X0048:  {0X0068},
+	0X0049:  {0X0069},
+	0X004A:  {0X006A},
+	0X004B:  {0X006B},
+	0X004C:  {0X006C},
+	0X004D:  {0X006D},
+	0X004E:  {0X006E},
+	0X004F:  {0X006F},
+	0X0050:  {0X0070},
+	0X0051:  {0X0071},
+	0X0052:  {0X0072},
+	0X0053:  {0X0073},
+	0X0054:  {0X0074},
+	0X0055:  {0X0075},
+	0X0056:  {0X0076},
+	0X0057:  {0X0077},
+	0X0058:  {0X0078},
+	0X0059:  {0X0079},
+	0X005A:  {0X007A},
+	0X00B5:  {0X03BC},
+	0X00C0:  {0X00E0},
+	0X00C1:  {0X00E1},
+	0X00C2:  {0X00E2},
+	0X00C3:  {0X00E3},
+	0X00C4:  {0X00E4},
+	0X00C5:  {0X00E5},
+	0X00C6:  {0X00E6},
+	0X00C7:  {0X00E7},
+	0X00C8:  {0X00E8},
+	0X00C9:  {0X00E9},
+	0X00CA:  {0X00EA},
+	0X00CB:  {0X00EB},
+	0X00CC:  {0X00EC},
+	0X00CD:  {0X00ED},
+	0X00CE:  {0X00EE},
+	0X00CF:  {0X00EF},
+	0X00D0:  {0X00F0},
+	0X00D1:  {0X00F1},
+	0X00D2:  {0X00F2},
+	0X00D3:  {0X00F3},
+	0X00D4:  {0X00F4},
+	0X00D5:  {0X00F5},
+	0X00D6:  {0X00F6},
+	0X00D8:  {0X00F8},
+	0X00D9:  {0X00F9},
+	0X00DA:  {0X00FA},
+	0X00DB:  {0X00FB},
+	0X00DC:  {0X00FC},
+	0X00DD:  {0X00FD},
+	0X00DE:  {0X00FE},
+	0X00DF:  {0X0073, 0X0073},
+	0X0100:  {0X0101},
+	0X0102:  {0X0103},
+	0X0104:  {0X0105},
+	0X0106:  {0X0107},
+	0X0108:  {0X0109},
+	0X010A:  {0X010B},
+	0X010C:  {0X010D},
+	0X010E:  {0X010F},
+	0X0110:  {0X0111},
+	0X0112:  {0X0113},
+	0X0114:  {0X0115},
+	0X0116:  {0X0117},
+	0X0118:  {0X0119},
+	0X011A:  {0X011B},
+	0X011C:  {0X011D},
+	0X011E:  {0X011F},
+	0X0120:  {0X0121},
+	0X0122:  {0X0123},
+	0X0124:  {0X0125},
+	0X0126:  {0X0127},
+	0X0128:  {0X0129},
+	0X012A:  {0X012B},
+	0X012C:  {0X012D},
+	0X012E:  {0X012F},
+	0X0130:  {0X0069, 0X0307},
+	0X0132:  {0X0133},
+	0X0134:  {0X0135},
+	0X0136:  {0X0137},
+	0X0139:  {0X013A},
+	0X013B:  {0X013C},
+	0X013D:  {0X013E},
+	0X013F:  {0X0140},
+	0X0141:  {0X0142},
+	0X0143:  {0X0144},
+	0X0145:  {0X0146},
+	0X0147:  {0X0148},
+	0X0149:  {0X02BC, 0X006E},
+	0X014A:  {0X014B},
+	0X014C:  {0X014D},
+	0X014E:  {0X014F},
+	0X0150:  {0X0151},
+	0X0152:  {0X0153},
+	0X0154:  {0X0155},
+	0X0156:  {0X0157},
+	0X0158:  {0X0159},
+	0X015A:  {0X015B},
+	0X015C:  {0X015D},
+	0X015E:  {0X015F},
+	0X0160:  {0X0161},
+	0X0162:  {0X0163},
+	0X0164:  {0X0165},
+	0X0166:  {0X0167},
+	0X0168:  {0X0169},
+	0X016A:  {0X016B},
+	0X016C:  {0X016D},
+	0X016E:  {0X016F},
+	0X0170:  {0X0171},
+	0X0172:  {0X0173},
+	0X0174:  {0X0175},
+	0X0176:  {0X0177},
+	0X0178:  {0X00FF},
+	0X0179:  {0X017A},
+	0X017B:  {0X017C},
+	0X017D:  {0X017E},
+	0X017F:  {0X0073},
+	0X0181:  {0X0253},
+	0X0182:  {0X0183},
+	0X0184:  {0X0185},
+	0X0186:  {0X0254},
+	0X0187:  {0X0188},
+	0X0189:  {0X0256},
+	0X018A:  {0X0257},
+	0X018B:  {0X018C},
+	0X018E:  {0X01DD},
+	0X018F:  {0X0259},
+	0X0190:  {0X025B},
+	0X0191:  {0X0192},
+	0X0193:  {0X0260},
+	0X0194:  {0X0263},
+	0X0196:  {0X0269},
+	0X0197:  {0X0268},
+	0X0198:  {0X0199},
+	0X019C:  {0X026F},
+	0X019D:  {0X0272},
+	0X019F:  {0X0275},
+	0X01A0:  {0X01A1},
+	0X01A2:  {0X01A3},
+	0X01A4:  {0X01A5},
+	0X01A6:  {0X0280},
+	0X01A7:  {0X01A8},
+	0X01A9:  {0X0283},
+	0X01AC:  {0X01AD},
+	0X01AE:  {0X0288},
+	0X01AF:  {0X01B0},
+	0X01B1:  {0X028A},
+	0X01B2:  {0X028B},
+	0X01B3:  {0X01B4},
+	0X01B5:  {0X01B6},
+	0X01B7:  {0X0292},
+	0X01B8:  {0X01B9},
+	0X01BC:  {0X01BD},
+	0X01C4:  {0X01C6},
+	0X01C5:  {0X01C6},
+	0X01C7:  {0X01C9},
+	0X01C8:  {0X01C9},
+	0X01CA:  {0X01CC},
+	0X01CB:  {0X01CC},
+	0X01CD:  {0X01CE},
+	0X01CF:  {0X01D0},
+	0X01D1:  {0X01D2},
+	0X01D3:  {0X01D4},
+	0X01D5:  {0X01D6},
+	0X01D7:  {0X01D8},
+	0X01D9:  {0X01DA},
+	0X01DB:  {0X01DC},
+	0X01DE:  {0X01DF},
+	0X01E0:  {0X01E1},
+	0X01E2:  {0X01E3},
+	0X01E4:  {0X01E5},
+	0X01E6:  {0X01E7},
+	0X01E8:  {0X01E9},
+	0X01EA:  {0X01EB},
+	0X01EC:  {0X01ED},
+	0X01EE:  {0X01EF},
+	0X01F0:  {0X006A, 0X030C},
+	0X01F1:  {0X01F3},
+	0X01F2:  {0X01F3},
+	0X01F4:  {0X01F5},
+	0X01F6:  {0X0195},
+	0X01F7:  {0X01BF},
+	0X01F8:  {0X01F9},
+	0X01FA:  {0X01FB},
+	0X01FC:  {0X01FD},
+	0X01FE:  {0X01FF},
+	0X0200:  {0X0201},
+	0X0202:  {0X0203},
+	0X0204:  {0X0205},
+	0X0206:  {0X0207},
+	0X0208:  {0X0209},
+	0X020A:  {0X020B},
+	0X020C:  {0X020D},
+	0X020E:  {0X020F},
+	0X0210:  {0X0211},
+	0X0212:  {0X0213},
+	0X0214:  {0X0215},
+	0X0216:  {0X0217},
+	0X0218:  {0X0219},
+	0X021A:  {0X021B},
+	0X021C:  {0X021D},
+	0X021E:  {0X021F},
+	0X0220:  {0X019E},
+	0X0222:  {0X0223},
+	0X0224:  {0X0225},
+	0X0226:  {0X0227},
+	0X0228:  {0X0229},
+	0X022A:  {0X022B},
+	0X022C:  {0X022D},
+	0X022E:  {0X022F},
+	0X0230:  {0X0231},
+	0X0232:  {0X0233},
+	0X0345:  {0X03B9},
+	0X037A:  {0X0020, 0X03B9},
+	0X0386:  {0X03AC},
+	0X0388:  {0X03AD},
+	0X0389:  {0X03AE},
+	0X038A:  {0X03AF},
+	0X038C:  {0X03CC},
+	0X038E:  {0X03CD},
+	0X038F:  {0X03CE},
+	0X0390:  {0X03B9, 0X0308, 0X0301},
+	0X0391:  {0X03B1},
+	0X0392:  {0X03B2},
+	0X0393:  {0X03B3},
+	0X0394:  {0X03B4},
+	0X0395:  {0X03B5},
+	0X0396:  {0X03B6},
+	0X0397:  {0X03B7},
+	0X0398:  {0X03B8},
+	0X0399:  {0X03B9},
+	0X039A:  {0X03BA},
+	0X039B:  {0X03BB},
+	0X039C:  {0X03BC},
+	0X039D:  {0X03BD},
+	0X039E:  {0X03BE},
+	0X039F:  {0X03BF},
+	0X03A0:  {0X03C0},
+	0X03A1:  {0X03C1},
+	0X03A3:  {0X03C3},
+	0X03A4:  {0X03C4},
+	0X03A5:  {0X03C5},
+	0X03A6:  {0X03C6},
+	0X03A7:  {0X03C7},
+	0X03A8:  {0X03C8},
+	0X03A9:  {0X03C9},
+	0X03AA:  {0X03CA},
+	0X03AB:  {0X03CB},
+	0X03B0:  {0X03C5, 0X0308, 0X0301},
+	0X03C2:  {0X03C3},
+	0X03D0:  {0X03B2},
+	0X03D1:  {0X03B8},
+	0X03D2:  {0X03C5},
+	0X03D3:  {0X03CD},
+	0X03D4:  {0X03CB},
+	0X03D5:  {0X03C6},
+	0X03D6:  {0X03C0},
+	0X03D8:  {0X03D9},
+	0X03DA:  {0X03DB},
+	0X03DC:  {0X03DD},
+	0X03DE:  {0X03DF},
+	0X03E0:  {0X03E1},
+	0X03E2:  {0X03E3},
+	0X03E4:  {0X03E5},
+	0X03E6:  {0X03E7},
+	0X03E8:  {0X03E9},
+	0X03EA:  {0X03EB},
+	0X03EC:  {0X03ED},
+	0X03EE:  {0X03EF},
+	0X03F0:  {0X03BA},
+	0X03F1:  {0X03C1},
+	0X03F2:  {0X03C3},
+	0X03F4:  {0X03B8},
+	0X03F5:  {0X03B5},
+	0X0400:  {0X0450},
+	0X0401:  {0X0451},
+	0X0402:  {0X0452},
+	0X0403:  {0X0453},
+	0X0404:  {0X0454},
+	0X0405:  {0X0455},
+	0X0406:  {0X0456},
+	0X0407:  {0X0457},
+	0X0408:  {0X0458},
+	0X0409:  {0X0459},
+	0X040A:  {0X045A},
+	0X040B:  {0X045B},
+	0X040C:  {0X045C},
+	0X040D:  {0X045D},
+	0X040E:  {0X045E},
+	0X040F:  {0X045F},
+	0X0410:  {0X0430},
+	0X0411:  {0X0431},
+	0X0412:  {0X0432},
+	0X0413:  {0X0433},
+	0X0414:  {0X0434},
+	0X0415:  {0X0435},
+	0X0416:  {0X0436},
+	0X0417:  {0X0437},
+	0X0418:  {0X0438},
+	0X0419:  {0X0439},
+	0X041A:  {0X043A},
+	0X041B:  {0X043B},
+	0X041C:  {0X043C},
+	0X041D:  {0X043D},
+	0X041E:  {0X043E},
+	0X041F:  {0X043F},
+	0X0420:  {0X0440},
+	0X0421:  {0X0441},
+	0X0422:  {0X0442},
+	0X0423:  {0X0443},
+	0X0424:  {0X0444},
+	0X0425:  {0X0445},
+	0X0426:  {0X0446},
+	0X0427:  {0X0447},
+	0X0428:  {0X0448},
+	0X0429:  {0X0449},
+	0X042A:  {0X044A},
+	0X042B:  {0X044B},
+	0X042C:  {0X044C},
+	0X042D:  {0X044D},
+	0X042E:  {0X044E},
+	0X042F:  {0X044F},
+	0X0460:  {0X0461},
+	0X0462:  {0X0463},
+	0X0464:  {0X0465},
+	0X0466:  {0X0467},
+	0X0468:  {0X0469},
+	0X046A:  {0X046B},
+	0X046C:  {0X046D},
+	0X046E:  {0X046F},
+	0X0470:  {0X0471},
+	0X0472:  {0X0473},
+	0X0474:  {0X0475},
+	0X0476:  {0X0477},
+	0X0478:  {0X0479},
+	0X047A:  {0X047B},
+	0X047C:  {0X047D},
+	0X047E:  {0X047F},
+	0X0480:  {0X0481},
+	0X048A:  {0X048B},
+	0X048C:  {0X048D},
+	0X048E:  {0X048F},
+	0X0490:  {0X0491},
+	0X0492:  {0X0493},
+	0X0494:  {0X0495},
+	0X0496:  {0X0497},
+	0X0498:  {0X0499},
+	0X049A:  {0X049B},
+	0X049C:  {0X049D},
+	0X049E:  {0X049F},
+	0X04A0:  {0X04A1},
+	0X04A2:  {0X04A3},
+	0X04A4:  {0X04A5},
+	0X04A6:  {0X04A7},
+	0X04A8:  {0X04A9},
+	0X04AA:  {0X04AB},
+	0X04AC:  {0X04AD},
+	0X04AE:  {0X04AF},
+	0X04B0:  {0X04B1},
+	0X04B2:  {0X04B3},
+	0X04B4:  {0X04B5},
+	0X04B6:  {0X04B7},
+	0X04B8:  {0X04B9},
+	0X04BA:  {0X04BB},
+	0X04BC:  {0X04BD},
+	0X04BE:  {0X04BF},
+	0X04C1:  {0X04C2},
+	0X04C3:  {0X04C4},
+	0X04C5:  {0X04C6},
+	0X04C7:  {0X04C8},
+	0X04C9:  {0X04CA},
+	0X04CB:  {0X04CC},
+	0X04CD:  {0X04CE},
+	0X04D0:  {0X04D1},
+	0X04D2:  {0X04D3},
+	0X04D4:  {0X04D5},
+	0X04D6:  {0X04D7},
+	0X04D8:  {0X04D9},
+	0X04DA:  {0X04DB},
+	0X04DC:  {0X04DD},
+	0X04DE:  {0X04DF},
+	0X04E0:  {0X04E1},
+	0X04E2:  {0X04E3},
+	0X04E4:  {0X04E5},
+	0X04E6:  {0X04E7},
+	0X04E8:  {0X04E9},
+	0X04EA:  {0X04EB},
+	0X04EC:  {0X04ED},
+	0X04EE:  {0X04EF},
+	0X04F0:  {0X04F1},
+	0X04F2:  {0X04F3},
+	0X04F4:  {0X04F5},
+	0X04F8:  {0X04F9},
+	0X0500:  {0X0501},
+	0X0502:  {0X0503},
+	0X0504:  {0X0505},
+	0X0506:  {0X0507},
+	0X0508:  {0X0509},
+	0X050A:  {0X050B},
+	0X050C:  {0X050D},
+	0X050E:  {0X050F},
+	0X0531:  {0X0561},
+	0X0532:  {0X0562},
+	0X0533:  {0X0563},
+	0X0534:  {0X0564},
+	0X0535:  {0X0565},
+	0X0536:  {0X0566},
+	0X0537:  {0X0567},
+	0X0538:  {0X0568},
+	0X0539:  {0X0569},
+	0X053A:  {0X056A},
+	0X053B:  {0X056B},
+	0X053C:  {0X056C},
+	0X053D:  {0X056D},
+	0X053E:  {0X056E},
+	0X053F:  {0X056F},
+	0X0540:  {0X0570},
+	0X0541:  {0X0571},
+	0X0542:  {0X0572},
+	0X0543:  {0X0573},
+	0X0544:  {0X0574},
+	0X0545:  {0X0575},
+	0X0546:  {0X0576},
+	0X0547:  {0X0577},
+	0X0548:  {0X0578},
+	0X0549:  {0X0579},
+	0X054A:  {0X057A},
+	0X054B:  {0X057B},
+	0X054C:  {0X057C},
+	0X054D:  {0X057D},
+	0X054E:  {0X057E},
+	0X054F:  {0X057F},
+	0X0550:  {0X0580},
+	0X0551:  {0X0581},
+	0X0552:  {0X0582},
+	0X0553:  {0X0583},
+	0X0554:  {0X0584},
+	0X0555:  {0X0585},
+	0X0556:  {0X0586},
+	0X0587:  {0X0565, 0X0582},
+	0X1E00:  {0X1E01},
+	0X1E02:  {0X1E03},
+	0X1E04:  {0X1E05},
+	0X1E06:  {0X1E07},
+	0X1E08:  {0X1E09},
+	0X1E0A:  {0X1E0B},
+	0X1E0C:  {0X1E0D},
+	0X1E0E:  {0X1E0F},
+	0X1E10:  {0X1E11},
+	0X1E12:  {0X1E13},
+	0X1E14:  {0X1E15},
+	0X1E16:  {0X1E17},
+	0X1E18:  {0X1E19},
+	0X1E1A:  {0X1E1B},
+	0X1E1C:  {0X1E1D},
+	0X1E1E:  {0X1E1F},
+	0X1E20:  {0X1E21},
+	0X1E22:  {0X1E23},
+	0X1E24:  {0X1E25},
+	0X1E26:  {0X1E27},
+	0X1E28:  {0X1E29},
+	0X1E2A:  {0X1E2B},
+	0X1E2C:  {0X1E2D},
+	0X1E2E:  {0X1E2F},
+	0X1E30:  {0X1E31},
+	0X1E32:  {0X1E33},
+	0X1E34:  {0X1E35},
+	0X1E36:  {0X1E37},
+	0X1E38:  {0X1E39},
+	0X1E3A:  {0X1E3B},
+	0X1E3C:  {0X1E3D},
+	0X1E3E:  {0X1E3F},
+	0X1E40:  {0X1E41},
+	0X1E42:  {0X1E43},
+	0X1E44:  {0X1E45},
+	0X1E46:  {0X1E47},
+	0X1E48:  {0X1E49},
+	0X1E4A:  {0X1E4B},
+	0X1E4C:  {0X1E4D},
+	0X1E4E:  {0X1E4F},
+	0X1E50:  {0X1E51},
+	0X1E52:  {0X1E53},
+	0X1E54:  {0X1E55},
+	0X1E56:  {0X1E57},
+	0X1E58:  {0X1E59},
+	0X1E5A:  {0X1E5B},
+	0X1E5C:  {0X1E5D},
+	0X1E5E:  {0X1E5F},
+	0X1E60:  {0X1E61},
+	0X1E62:  {0X1E63},
+	0X1E64:  {0X1E65},
+	0X1E66:  {0X1E67},
+	0X1E68:  {0X1E69},
+	0X1E6A:  {0X1E6B},
+	0X1E6C:  {0X1E6D},
+	0X1E6E:  {0X1E6F},
+	0X1E70:  {0X1E71},
+	0X1E72:  {0X1E73},
+	0X1E74:  {0X1E75},
+	0X1E76:  {0X1E77},
+	0X1E78:  {0X1E79},
+	0X1E7A:  {0X1E7B},
+	0X1E7C:  {0X1E7D},
+	0X1E7E:  {0X1E7F},
+	0X1E80:  {0X1E81},
+	0X1E82:  {0X1E83},
+	0X1E84:  {0X1E85},
+	0X1E86:  {0X1E87},
+	0X1E88:  {0X1E89},
+	0X1E8A:  {0X1E8B},
+	0X1E8C:  {0X1E8D},
+	0X1E8E:  {0X1E8F},
+	0X1E90:  {0X1E91},
+	0X1E92:  {0X1E93},
+	0X1E94:  {0X1E95},
+	0X1E96:  {0X0068, 0X0331},
+	0X1E97:  {0X0074, 0X0308},
+	0X1E98:  {0X0077, 0X030A},
+	0X1E99:  {0X0079, 0X030A},
+	0X1E9A:  {0X0061, 0X02BE},
+	0X1E9B:  {0X1E61},
+	0X1EA0:  {0X1EA1},
+	0X1EA2:  {0X1EA3},
+	0X1EA4:  {0X1EA5},
+	0X1EA6:  {0X1EA7},
+	0X1EA8:  {0X1EA9},
+	0X1EAA:  {0X1EAB},
+	0X1EAC:  {0X1EAD},
+	0X1EAE:  {0X1EAF},
+	0X1EB0:  {0X1EB1},
+	0X1EB2:  {0X1EB3},
+	0X1EB4:  {0X1EB5},
+	0X1EB6:  {0X1EB7},
+	0X1EB8:  {0X1EB9},
+	0X1EBA:  {0X1EBB},
+	0X1EBC:  {0X1EBD},
+	0X1EBE:  {0X1EBF},
+	0X1EC0:  {0X1EC1},
+	0X1EC2:  {0X1EC3},
+	0X1EC4:  {0X1EC5},
+	0X1EC6:  {0X1EC7},
+	0X1EC8:  {0X1EC9},
+	0X1ECA:  {0X1ECB},
+	0X1ECC:  {0X1ECD},
+	0X1ECE:  {0X1ECF},
+	0X1ED0:  {0X1ED1},
+	0X1ED2:  {0X1ED3},
+	0X1ED4:  {0X1ED5},
+	0X1ED6:  {0X1ED7},
+	0X1ED8:  {0X1ED9},
+	0X1EDA:  {0X1EDB},
+	0X1EDC:  {0X1EDD},
+	0X1EDE:  {0X1EDF},
+	0X1EE0:  {0X1EE1},
+	0X1EE2:  {0X1EE3},
+	0X1EE4:  {0X1EE5},
+	0X1EE6:  {0X1EE7},
+	0X1EE8:  {0X1EE9},
+	0X1EEA:  {0X1EEB},
+	0X1EEC:  {0X1EED},
+	0X1EEE:  {0X1EEF},
+	0X1EF0:  {0X1EF1},
+	0X1EF2:  {0X1EF3},
+	0X1EF4:  {0X1EF5},
+	0X1EF6:  {0X1EF7},
+	0X1EF8:  {0X1EF9},
+	0X1F08:  {0X1F00},
+	0X1F09:  {0X1F01},
+	0X1F0A:  {0X1F02},
+	0X1F0B:  {0X1F03},
+	0X1F0C:  {0X1F04},
+	0X1F0D:  {0X1F05},
+	0X1F0E:  {0X1F06},
+	0X1F0F:  {0X1F07},
+	0X1F18:  {0X1F10},
+	0X1F19:  {0X1F11},
+	0X1F1A:  {0X1F12},
+	0X1F1B:  {0X1F13},
+	0X1F1C:  {0X1F14},
+	0X1F1D:  {0X1F15},
+	0X1F28:  {0X1F20},
+	0X1F29:  {0X1F21},
+	0X1F2A:  {0X1F22},
+	0X1F2B:  {0X1F23},
+	0X1F2C:  {0X1F24},
+	0X1F2D:  {0X1F25},
+	0X1F2E:  {0X1F26},
+	0X1F2F:  {0X1F27},
+	0X1F38:  {0X1F30},
+	0X1F39:  {0X1F31},
+	0X1F3A:  {0X1F32},
+	0X1F3B:  {0X1F33},
+	0X1F3C:  {0X1F34},
+	0X1F3D:  {0X1F35},
+	0X1F3E:  {0X1F36},
+	0X1F3F:  {0X1F37},
+	0X1F48:  {0X1F40},
+	0X1F49:  {0X1F41},
+	0X1F4A:  {0X1F42},
+	0X1F4B:  {0X1F43},
+	0X1F4C:  {0X1F44},
+	0X1F4D:  {0X1F45},
+	0X1F50:  {0X03C5, 0X0313},
+	0X1F52:  {0X03C5, 0X0313, 0X0300},
+	0X1F54:  {0X03C5, 0X0313, 0X0301},
+	0X1F56:  {0X03C5, 0X0313, 0X0342},
+	0X1F59:  {0X1F51},
+	0X1F5B:  {0X1F53},
+	0X1F5D:  {0X1F55},
+	0X1F5F:  {0X1F57},
+	0X1F68:  {0X1F60},
+	0X1F69:  {0X1F61},
+	0X1F6A:  {0X1F62},
+	0X1F6B:  {0X1F63},
+	0X1F6C:  {0X1F64},
+	0X1F6D:  {0X1F65},
+	0X1F6E:  {0X1F66},
+	0X1F6F:  {0X1F67},
+	0X1F80:  {0X1F00, 0X03B9},
+	0X1F81:  {0X1F01, 0X03B9},
+	0X1F82:  {0X1F02, 0X03B9},
+	0X1F83:  {0X1F03, 0X03B9},
+	0X1F84:  {0X1F04, 0X03B9},
+	0X1F85:  {0X1F05, 0X03B9},
+	0X1F86:  {0X1F06, 0X03B9},
+	0X1F87:  {0X1F07, 0X03B9},
+	0X1F88:  {0X1F00, 0X03B9},
+	0X1F89:  {0X1F01, 0X03B9},
+	0X1F8A:  {0X1F02, 0X03B9},
+	0X1F8B:  {0X1F03, 0X03B9},
+	0X1F8C:  {0X1F04, 0X03B9},
+	0X1F8D:  {0X1F05, 0X03B9},
+	0X1F8E:  {0X1F06, 0X03B9},
+	0X1F8F:  {0X1F07, 0X03B9},
+	0X1F90:  {0X1F20, 0X03B9},
+	0X1F91:  {0X1F21, 0X03B9},
+	0X1F92:  {0X1F22, 0X03B9},
+	0X1F93:  {0X1F23, 0X03B9},
+	0X1F94:  {0X1F24, 0X03B9},
+	0X1F95:  {0X1F25, 0X03B9},
+	0X1F96:  {0X1F26, 0X03B9},
+	0X1F97:  {0X1F27, 0X03B9},
+	0X1F98:  {0X1F20, 0X03B9},
+	0X1F99:  {0X1F21, 0X03B9},
+	0X1F9A:  {0X1F22, 0X03B9},
+	0X1F9B:  {0X1F23, 0X03B9},
+	0X1F9C:  {0X1F24, 0X03B9},
+	0X1F9D:  {0X1F25, 0X03B9},
+	0X1F9E:  {0X1F26, 0X03B9},
+	0X1F9F:  {0X1F27, 0X03B9},
+	0X1FA0:  {0X1F60, 0X03B9},
+	0X1FA1:  {0X1F61, 0X03B9},
+	0X1FA2:  {0X1F62, 0X03B9},
+	0X1FA3:  {0X1F63, 0X03B9},
+	0X1FA4:  {0X1F64, 0X03B9},
+	0X1FA5:  {0X1F65, 0X03B9},
+	0X1FA6:  {0X1F66, 0X03B9},
+	0X1FA7:  {0X1F67, 0X03B9},
+	0X1FA8:  {0X1F60, 0X03B9},
+	0X1FA9:  {0X1F61, 0X03B9},
+	0X1FAA:  {0X1F62, 0X03B9},
+	0X1FAB:  {0X1F63, 0X03B9},
+	0X1FAC:  {0X1F64, 0X03B9},
+	0X1FAD:  {0X1F65, 0X03B9},
+	0X1FAE:  {0X1F66, 0X03B9},
+	0X1FAF:  {0X1F67, 0X03B9},
+	0X1FB2:  {0X1F70, 0X03B9},
+	0X1FB3:  {0X03B1, 0X03B9},
+	0X1FB4:  {0X03AC, 0X03B9},
+	0X1FB6:  {0X03B1, 0X0342},
+	0X1FB7:  {0X03B1, 0X0342, 0X03B9},
+	0X1FB8:  {0X1FB0},
+	0X1FB9:  {0X1FB1},
+	0X1FBA:  {0X1F70},
+	0X1FBB:  {0X1F71},
+	0X1FBC:  {0X03B1, 0X03B9},
+	0X1FBE:  {0X03B9},
+	0X1FC2:  {0X1F74, 0X03B9},
+	0X1FC3:  {0X03B7, 0X03B9},
+	0X1FC4:  {0X03AE, 0X03B9},
+	0X1FC6:  {0X03B7, 0X0342},
+	0X1FC7:  {0X03B7, 0X0342, 0X03B9},
+	0X1FC8:  {0X1F72},
+	0X1FC9:  {0X1F73},
+	0X1FCA:  {0X1F74},
+	0X1FCB:  {0X1F75},
+	0X1FCC:  {0X03B7, 0X03B9},
+	0X1FD2:  {0X03B9, 0X0308, 0X0300},
+	0X1FD3:  {0X03B9, 0X0308, 0X0301},
+	0X1FD6:  {0X03B9, 0X0342},
+	0X1FD7:  {0X03B9, 0X0308, 0X0342},
+	0X1FD8:  {0X1FD0},
+	0X1FD9:  {0X1FD1},
+	0X1FDA:  {0X1F76},
+	0X1FDB:  {0X1F77},
+	0X1FE2:  {0X03C5, 0X0308, 0X0300},
+	0X1FE3:  {0X03C5, 0X0308, 0X0301},
+	0X1FE4:  {0X03C1, 0X0313},
+	0X1FE6:  {0X03C5, 0X0342},
+	0X1FE7:  {0X03C5, 0X0308, 0X0342},
+	0X1FE8:  {0X1FE0},
+	0X1FE9:  {0X1FE1},
+	0X1FEA:  {0X1F7A},
+	0X1FEB:  {0X1F7B},
+	0X1FEC:  {0X1FE5},
+	0X1FF2:  {0X1F7C, 0X03B9},
+	0X1FF3:  {0X03C9, 0X03B9},
+	0X1FF4:  {0X03CE, 0X03B9},
+	0X1FF6:  {0X03C9, 0X0342},
+	0X1FF7:  {0X03C9, 0X0342, 0X03B9},
+	0X1FF8:  {0X1F78},
+	0X1FF9:  {0X1F79},
+	0X1FFA:  {0X1F7C},
+	0X1FFB:  {0X1F7D},
+	0X1FFC:  {0X03C9, 0X03B9},
+	0X20A8:  {0X0072, 0X0073},
+	0X2102:  {0X0063},
+	0X2103:  {0X00B0, 0X0063},
+	0X2107:  {0X025B},
+	0X2109:  {0X00B0, 0X0066},
+	0X210B:  {0X0068},
+	0X210C:  {0X0068},
+	0X210D:  {0X0068},
+	0X2110:  {0X0069},
+	0X2111:  {0X0069},
+	0X2112:  {0X006C},
+	0X2115:  {0X006E},
+	0X2116:  {0X006E, 0X006F},
+	0X2119:  {0X0070},
+	0X211A:  {0X0071},
+	0X211B:  {0X0072},
+	0X211C:  {0X0072},
+	0X211D:  {0X0072},
+	0X2120:  {0X0073, 0X006D},
+	0X2121:  {0X0074, 0X0065, 0X006C},
+	0X2122:  {0X0074, 0X006D},
+	0X2124:  {0X007A},
+	0X2126:  {0X03C9},
+	0X2128:  {0X007A},
+	0X212A:  {0X006B},
+	0X212B:  {0X00E5},
+	0X212C:  {0X0062},
+	0X212D:  {0X0063},
+	0X2130:  {0X0065},
+	0X2131:  {0X0066},
+	0X2133:  {0X006D},
+	0X213E:  {0X03B3},
+	0X213F:  {0X03C0},
+	0X2145:  {0X0064},
+	0X2160:  {0X2170},
+	0X2161:  {0X2171},
+	0X2162:  {0X2172},
+	0X2163:  {0X2173},
+	0X2164:  {0X2174},
+	0X2165:  {0X2175},
+	0X2166:  {0X2176},
+	0X2167:  {0X2177},
+	0X2168:  {0X2178},
+	0X2169:  {0X2179},
+	0X216A:  {0X217A},
+	0X216B:  {0X217B},
+	0X216C:  {0X217C},
+	0X216D:  {0X217D},
+	0X216E:  {0X217E},
+	0X216F:  {0X217F},
+	0X24B6:  {0X24D0},
+	0X24B7:  {0X24D1},
+	0X24B8:  {0X24D2},
+	0X24B9:  {0X24D3},
+	0X24BA:  {0X24D4},
+	0X24BB:  {0X24D5},
+	0X24BC:  {0X24D6},
+	0X24BD:  {0X24D7},
+	0X24BE:  {0X24D8},
+	0X24BF:  {0X24D9},
+	0X24C0:  {0X24DA},
+	0X24C1:  {0X24DB},
+	0X24C2:  {0X24DC},
+	0X24C3:  {0X24DD},
+	0X24C4:  {0X24DE},
+	0X24C5:  {0X24DF},
+	0X24C6:  {0X24E0},
+	0X24C7:  {0X24E1},
+	0X24C8:  {0X24E2},
+	0X24C9:  {0X24E3},
+	0X24CA:  {0X24E4},
+	0X24CB:  {0X24E5},
+	0X24CC:  {0X24E6},
+	0X24CD:  {0X24E7},
+	0X24CE:  {0X24E8},
+	0X24CF:  {0X24E9},
+	0X3371:  {0X0068, 0X0070, 0X0061},
+	0X3373:  {0X0061, 0X0075},
+	0X3375:  {0X006F, 0X0076},
+	0X3380:  {0X0070, 0X0061},
+	0X3381:  {0X006E, 0X0061},
+	0X3382:  {0X03BC, 0X0061},
+	0X3383:  {0X006D, 0X0061},
+	0X3384:  {0X006B, 0X0061},
+	0X3385:  {0X006B, 0X0062},
+	0X3386:  {0X006D, 0X0062},
+	0X3387:  {0X0067, 0X0062},
+	0X338A:  {0X0070, 0X0066},
+	0X338B:  {0X006E, 0X0066},
+	0X338C:  {0X03BC, 0X0066},
+	0X3390:  {0X0068, 0X007A},
+	0X3391:  {0X006B, 0X0068, 0X007A},
+	0X3392:  {0X006D, 0X0068, 0X007A},
+	0X3393:  {0X0067, 0X0068, 0X007A},
+	0X3394:  {0X0074, 0X0068, 0X007A},
+	0X33A9:  {0X0070, 0X0061},
+	0X33AA:  {0X006B, 0X0070, 0X0061},
+	0X33AB:  {0X006D, 0X0070, 0X0061},
+	0X33AC:  {0X0067, 0X0070, 0X0061},
+	0X33B4:  {0X0070, 0X0076},
+	0X33B5:  {0X006E, 0X0076},
+	0X33B6:  {0X03BC, 0X0076},
+	0X33B7:  {0X006D, 0X0076},
+	0X33B8:  {0X006B, 0X0076},
+	0X33B9:  {0X006D, 0X0076},
+	0X33BA:  {0X0070, 0X0077},
+	0X33BB:  {0X006E, 0X0077},
+	0X33BC:  {0X03BC, 0X0077},
+	0X33BD:  {0X006D, 0X0077},
+	0X33BE:  {0X006B, 0X0077},
+	0X33BF:  {0X006D, 0X0077},
+	0X33C0:  {0X006B, 0X03C9},
+	0X33C1:  {0X006D, 0X03C9},
+	0X33C3:  {0X0062, 0X0071},
+	0X33C6:  {0X0063, 0X2215, 0X006B, 0X0067},
+	0X33C7:  {0X0063, 0X006F, 0X002E},
+	0X33C8:  {0X0064, 0X0062},
+	0X33C9:  {0X0067, 0X0079},
+	0X33CB:  {0X0068, 0X0070},
+	0X33CD:  {0X006B, 0X006B},
+	0X33CE:  {0X006B, 0X006D},
+	0X33D7:  {0X0070, 0X0068},
+	0X33D9:  {0X0070, 0X0070, 0X006D},
+	0X33DA:  {0X0070, 0X0072},
+	0X33DC:  {0X0073, 0X0076},
+	0X33DD:  {0X0077, 0X0062},
+	0XFB00:  {0X0066, 0X0066},
+	0XFB01:  {0X0066, 0X0069},
+	0XFB02:  {0X0066, 0X006C},
+	0XFB03:  {0X0066, 0X0066, 0X0069},
+	0XFB04:  {0X0066, 0X0066, 0X006C},
+	0XFB05:  {0X0073, 0X0074},
+	0XFB06:  {0X0073, 0X0074},
+	0XFB13:  {0X0574, 0X0576},
+	0XFB14:  {0X0574, 0X0565},
+	0XFB15:  {0X0574, 0X056B},
+	0XFB16:  {0X057E, 0X0576},
+	0XFB17:  {0X0574, 0X056D},
+	0XFF21:  {0XFF41},
+	0XFF22:  {0XFF42},
+	0XFF23:  {0XFF43},
+	0XFF24:  {0XFF44},
+	0XFF25:  {0XFF45},
+	0XFF26:  {0XFF46},
+	0XFF27:  {0XFF47},
+	0XFF28:  {0XFF48},
+	0XFF29:  {0XFF49},
+	0XFF2A:  {0XFF4A},
+	0XFF2B:  {0XFF4B},
+	0XFF2C:  {0XFF4C},
+	0XFF2D:  {0XFF4D},
+	0XFF2E:  {0XFF4E},
+	0XFF2F:  {0XFF4F},
+	0XFF30:  {0XFF50},
+	0XFF31:  {0XFF51},
+	0XFF32:  {0XFF52},
+	0XFF33:  {0XFF53},
+	0XFF34:  {0XFF54},
+	0XFF35:  {0XFF55},
+	0XFF36:  {0XFF56},
+	0XFF37:  {0XFF57},
+	0XFF38:  {0XFF58},
+	0XFF39:  {0XFF59},
+	0XFF3A:  {0XFF5A},
+	0X10400: {0X10428},
+	0X10401: {0X10429},
+	0X10402: {0X1042A},
+	0X10403: {0X1042B},
+	0X10404: {0X1042C},
+	0X10405: {0X1042D},
+	0X10406: {0X1042E},
+	0X10407: {0X1042F},
+	0X10408: {0X10430},
+	0X10409: {0X10431},
+	0X1040A: {0X10432},
+	0X1040B: {0X10433},
+	0X1040C: {0X10434},
+	0X1040D: {0X10435},
+	0X1040E: {0X10436},
+	0X1040F: {0X10437},
+	0X10410: {0X10438},
+	0X10411: {0X10439},
+	0X10412: {0X1043A},
+	0X10413: {0X1043B},
+	0X10414: {0X1043C},
+	0X10415: {0X1043D},
+	0X10416: {0X1043E},
+	0X10417: {0X1043F},
+	0X10418: {0X10440},
+	0X10419: {0X10441},
+	0X1041A: {0X10442},
+	0X1041B: {0X10443},
+	0X1041C: {0X10444},
+	0X1041D: {0X10445},
+	0X1041E: {0X10446},
+	0X1041F: {0X10447},
+	0X10420: {0X10448},
+	0X10421: {0X10449},
+	0X10422: {0X1044A},
+	0X10423: {0X1044B},
+	0X10424: {0X1044C},
+	0X10425: {0X1044D},
+	0X1D400: {0X0061},
+	0X1D401: {0X0062},
+	0X1D402: {0X0063},
+	0X1D403: {0X0064},
+	0X1D404: {0X0065},
+	0X1D405: {0X0066},
+	0X1D406: {0X0067},
+	0X1D407: {0X0068},
+	0X1D408: {0X0069},
+	0X1D409: {0X006A},
+	0X1D40A: {0X006B},
+	0X1D40B: {0X006C},
+	0X1D40C: {0X006D},
+	0X1D40D: {0X006E},
+	0X1D40E: {0X006F},
+	0X1D40F: {0X0070},
+	0X1D410: {0X0071},
+	0X1D411: {0X0072},
+	0X1D412: {0X0073},
+	0X1D413: {0X0074},
+	0X1D414: {0X0075},
+	0X1D415: {0X0076},
+	0X1D416: {0X0077},
+	0X1D417: {0X0078},
+	0X1D418: {0X0079},
+	0X1D419: {0X007A},
+	0X1D434: {0X0061},
+	0X1D435: {0X0062},
+	0X1D436: {0X0063},
+	0X1D437: {0X0064},
+	0X1D438: {0X0065},
+	0X1D439: {0X0066},
+	0X1D43A: {0X0067},
+	0X1D43B: {0X0068},
+	0X1D43C: {0X0069},
+	0X1D43D: {0X006A},
+	0X1D43E: {0X006B},
+	0X1D43F: {0X006C},
+	0X1D440: {0X006D},
+	0X1D441: {0X006E},
+	0X1D442: {0X006F},
+	0X1D443: {0X0070},
+	0X1D444: {0X0071},
+	0X1D445: {0X0072},
+	0X1D446: {0X0073},
+	0X1D447: {0X0074},
+	0X1D448: {0X0075},
+	0X1D449: {0X0076},
+	0X1D44A: {0X0077},
+	0X1D44B: {0X0078},
+	0X1D44C: {0X0079},
+	0X1D44D: {0X007A},
+	0X1D468: {0X0061},
+	0X1D469: {0X0062},
+	0X1D46A: {0X0063},
+	0X1D46B: {0X0064},
+	0X1D46C: {0X0065},
+	0X1D46D: {0X0066},
+	0X1D46E: {0X0067},
+	0X1D46F: {0X0068},
+	0X1D470: {0X0069},
+	0X1D471: {0X006A},
+	0X1D472: {0X006B},
+	0X1D473: {0X006C},
+	0X1D474: {0X006D},
+	0X1D475: {0X006E},
+	0X1D476: {0X006F},
+	0X1D477: {0X0070},
+	0X1D478: {0X0071},
+	0X1D479: {0X0072},
+	0X1D47A: {0X0073},
+	0X1D47B: {0X0074},
+	0X1D47C: {0X0075},
+	0X1D47D: {0X0076},
+	0X1D47E: {0X0077},
+	0X1D47F: {0X0078},
+	0X1D480: {0X0079},
+	0X1D481: {0X007A},
+	0X1D49C: {0X0061},
+	0X1D49E: {0X0063},
+	0X1D49F: {0X0064},
+	0X1D4A2: {0X0067},
+	0X1D4A5: {0X006A},
+	0X1D4A6: {0X006B},
+	0X1D4A9: {0X006E},
+	0X1D4AA: {0X006F},
+	0X1D4AB: {0X0070},
+	0X1D4AC: {0X0071},
+	0X1D4AE: {0X0073},
+	0X1D4AF: {0X0074},
+	0X1D4B0: {0X0075},
+	0X1D4B1: {0X0076},
+	0X1D4B2: {0X0077},
+	0X1D4B3: {0X0078},
+	0X1D4B4: {0X0079},
+	0X1D4B5: {0X007A},
+	0X1D4D0: {0X0061},
+	0X1D4D1: {0X0062},
+	0X1D4D2: {0X0063},
+	0X1D4D3: {0X0064},
+	0X1D4D4: {0X0065},
+	0X1D4D5: {0X0066},
+	0X1D4D6: {0X0067},
+	0X1D4D7: {0X0068},
+	0X1D4D8: {0X0069},
+	0X1D4D9: {0X006A},
+	0X1D4DA: {0X006B},
+	0X1D4DB: {0X006C},
+	0X1D4DC: {0X006D},
+	0X1D4DD: {0X006E},
+	0X1D4DE: {0X006F},
+	0X1D4DF: {0X0070},
+	0X1D4E0: {0X0071},
+	0X1D4E1: {0X0072},
+	0X1D4E2: {0X0073},
+	0X1D4E3: {0X0074},
+	0X1D4E4: {0X0075},
+	0X1D4E5: {0X0076},
+	0X1D4E6: {0X0077},
+	0X1D4E7: {0X0078},
+	0X1D4E8: {0X0079},
+	0X1D4E9: {0X007A},
+	0X1D504: {0X0061},
+	0X1D505: {0X0062},
+	0X1D507: {0X0064},
+	0X1D508: {0X0065},
+	0X1D509: {0X0066},
+	0X1D50A: {0X0067},
+	0X1D50D: {0X006A},
+	0X1D50E: {0X006B},
+	0X1D50F: {0X006C},
+	0X1D510: {0X006D},
+	0X1D511: {0X006E},
+	0X1D512: {0X006F},
+	0X1D513: {0X0070},
+	0X1D514: {0X0071},
+	0X1D516: {0X0073},
+	0X1D517: {0X0074},
+	0X1D518: {0X0075},
+	0X1D519: {0X0076},
+	0X1D51A: {0X0077},
+	0X1D51B: {0X0078},
+	0X1D51C: {0X0079},
+	0X1D538: {0X0061},
+	0X1D539: {0X0062},
+	0X1D53B: {0X0064},
+	0X1D53C: {0X0065},
+	0X1D53D: {0X0066},
+	0X1D53E: {0X0067},
+	0X1D540: {0X0069},
+	0X1D541: {0X006A},
+	0X1D542: {0X006B},
+	0X1D543: {0X006C},
+	0X1D544: {0X006D},
+	0X1D546: {0X006F},
+	0X1D54A: {0X0073},
+	0X1D54B: {0X0074},
+	0X1D54C: {0X0075},
+	0X1D54D: {0X0076},
+	0X1D54E: {0X0077},
+	0X1D54F: {0X0078},
+	0X1D550: {0X0079},
+	0X1D56C: {0X0061},
+	0X1D56D: {0X0062},
+	0X1D56E: {0X0063},
+	0X1D56F: {0X0064},
+	0X1D570: {0X0065},
+	0X1D571: {0X0066},
+	0X1D572: {0X0067},
+	0X1D573: {0X0068},
+	0X1D574: {0X0069},
+	0X1D575: {0X006A},
+	0X1D576: {0X006B},
+	0X1D577: {0X006C},
+	0X1D578: {0X006D},
+	0X1D579: {0X006E},
+	0X1D57A: {0X006F},
+	0X1D57B: {0X0070},
+	0X1D57C: {0X0071},
+	0X1D57D: {0X0072},
+	0X1D57E: {0X0073},
+	0X1D57F: {0X0074},
+	0X1D580: {0X0075},
+	0X1D581: {0X0076},
+	0X1D582: {0X0077},
+	0X1D583: {0X0078},
+	0X1D584: {0X0079},
+	0X1D585: {0X007A},
+	0X1D5A0: {0X0061},
+	0X1D5A1: {0X0062},
+	0X1D5A2: {0X0063},
+	0X1D5A3: {0X0064},
+	0X1D5A4: {0X0065},
+	0X1D5A5: {0X0066},
+	0X1D5A6: {0X0067},
+	0X1D5A7: {0X0068},
+	0X1D5A8: {0X0069},
+	0X1D5A9: {0X006A},
+	0X1D5AA: {0X006B},
+	0X1D5AB: {0X006C},
+	0X1D5AC: {0X006D},
+	0X1D5AD: {0X006E},
+	0X1D5AE: {0X006F},
+	0X1D5AF: {0X0070},
+	0X1D5B0: {0X0071},
+	0X1D5B1: {0X0072},
+	0X1D5B2: {0X0073},
+	0X1D5B3: {0X0074},
+	0X1D5B4: {0X0075},
+	0X1D5B5: {0X0076},
+	0X1D5B6: {0X0077},
+	0X1D5B7: {0X0078},
+	0X1D5B8: {0X0079},
+	0X1D5B9: {0X007A},
+	0X1D5D4: {0X0061},
+	0X1D5D5: {0X0062},
+	0X1D5D6: {0X0063},
+	0X1D5D7: {0X0064},
+	0X1D5D8: {0X0065},
+	0X1D5D9: {0X0066},
+	0X1D5DA: {0X0067},
+	0X1D5DB: {0X0068},
+	0X1D5DC: {0X0069},
+	0X1D5DD: {0X006A},
+	0X1D5DE: {0X006B},
+	0X1D5DF: {0X006C},
+	0X1D5E0: {0X006D},
+	0X1D5E1: {0X006E},
+	0X1D5E2: {0X006F},
+	0X1D5E3: {0X0070},
+	0X1D5E4: {0X0071},
+	0X1D5E5: {0X0072},
+	0X1D5E6: {0X0073},
+	0X1D5E7: {0X0074},
+	0X1D5E8: {0X0075},
+	0X1D5E9: {0X0076},
+	0X1D5EA: {0X0077},
+	0X1D5EB: {0X0078},
+	0X1D5EC: {0X0079},
+	0X1D5ED: {0X007A},
+	0X1D608: {0X0061},
+	0X1D609: {0X0062},
+	0X1D60A: {0X0063},
+	0X1D60B: {0X0064},
+	0X1D60C: {0X0065},
+	0X1D60D: {0X0066},
+	0X1D60E: {0X0067},
+	0X1D60F: {0X0068},
+	0X1D610: {0X0069},
+	0X1D611: {0X006A},
+	0X1D612: {0X006B},
+	0X1D613: {0X006C},
+	0X1D614: {0X006D},
+	0X1D615: {0X006E},
+	0X1D616: {0X006F},
+	0X1D617: {0X0070},
+	0X1D618: {0X0071},
+	0X1D619: {0X0072},
+	0X1D61A: {0X0073},
+	0X1D61B: {0X0074},
+	0X1D61C: {0X0075},
+	0X1D61D: {0X0076},
+	0X1D61E: {0X0077},
+	0X1D61F: {0X0078},
+	0X1D620: {0X0079},
+	0X1D621: {0X007A},
+	0X1D63C: {0X0061},
+	0X1D63D: {0X0062},
+	0X1D63E: {0X0063},
+	0X1D63F: {0X0064},
+	0X1D640: {0X0065},
+	0X1D641: {0X0066},
+	0X1D642: {0X0067},
+	0X1D643: {0X0068},
+	0X1D644: {0X0069},
+	0X1D645: {0X006A},
+	0X1D646: {0X006B},
+	0X1D647: {0X006C},
+	0X1D648: {0X006D},
+	0X1D649: {0X006E},
+	0X1D64A: {0X006F},
+	0X1D64B: {0X0070},
+	0X1D64C: {0X0071},
+	0X1D64D: {0X0072},
+	0X1D64E: {0X0073},
+	0X1D64F: {0X0074},
+	0X1D650: {0X0075},
+	0X1D651: {0X0076},
+	0X1D652: {0X0077},
+	0X1D653: {0X0078},
+	0X1D654: {0X0079},
+	0X1D655: {0X007A},
+	0X1D670: {0X0061},
+	0X1D671: {0X0062},
+	0X1D672: {0X0063},
+	0X1D673: {0X0064},
+	0X1D674: {0X0065},
+	0X1D675: {0X0066},
+	0X1D676: {0X0067},
+	0X1D677: {0X0068},
+	0X1D678: {0X0069},
+	0X1D679: {0X006A},
+	0X1D67A: {0X006B},
+	0X1D67B: {0X006C},
+	0X1D67C: {0X006D},
+	0X1D67D: {0X006E},
+	0X1D67E: {0X006F},
+	0X1D67F: {0X0070},
+	0X1D680: {0X0071},
+	0X1D681: {0X0072},
+	0X1D682: {0X0073},
+	0X1D683: {0X0074},
+	0X1D684: {0X0075},
+	0X1D685: {0X0076},
+	0X1D686: {0X0077},
+	0X1D687: {0X0078},
+	0X1D688: {0X0079},
+	0X1D689: {0X007A},
+	0X1D6A8: {0X03B1},
+	0X1D6A9: {0X03B2},
+	0X1D6AA: {0X03B3},
+	0X1D6AB: {0X03B4},
+	0X1D6AC: {0X03B5},
+	0X1D6AD: {0X03B6},
+	0X1D6AE: {0X03B7},
+	0X1D6AF: {0X03B8},
+	0X1D6B0: {0X03B9},
+	0X1D6B1: {0X03BA},
+	0X1D6B2: {0X03BB},
+	0X1D6B3: {0X03BC},
+	0X1D6B4: {0X03BD},
+	0X1D6B5: {0X03BE},
+	0X1D6B6: {0X03BF},
+	0X1D6B7: {0X03C0},
+	0X1D6B8: {0X03C1},
+	0X1D6B9: {0X03B8},
+	0X1D6BA: {0X03C3},
+	0X1D6BB: {0X03C4},
+	0X1D6BC: {0X03C5},
+	0X1D6BD: {0X03C6},
+	0X1D6BE: {0X03C7},
+	0X1D6BF: {0X03C8},
+	0X1D6C0: {0X03C9},
+	0X1D6D3: {0X03C3},
+	0X1D6E2: {0X03B1},
+	0X1D6E3: {0X03B2},
+	0X1D6E4: {0X03B3},
+	0X1D6E5: {0X03B4},
+	0X1D6E6: {0X03B5},
+	0X1D6E7: {0X03B6},
+	0X1D6E8: {0X03B7},
+	0X1D6E9: {0X03B8},
+	0X1D6EA: {0X03B9},
+	0X1D6EB: {0X03BA},
+	0X1D6EC: {0X03BB},
+	0X1D6ED: {0X03BC},
+	0X1D6EE: {0X03BD},
+	0X1D6EF: {0X03BE},
+	0X1D6F0: {0X03BF},
+	0X1D6F1: {0X03C0},
+	0X1D6F2: {0X03C1},
+	0X1D6F3: {0X03B8},
+	0X1D6F4: {0X03C3},
+	0X1D6F5: {0X03C4},
+	0X1D6F6: {0X03C5},
+	0X1D6F7: {0X03C6},
+	0X1D6F8: {0X03C7},
+	0X1D6F9: {0X03C8},
+	0X1D6FA: {0X03C9},
+	0X1D70D: {0X03C3},
+	0X1D71C: {0X03B1},
+	0X1D71D: {0X03B2},
+	0X1D71E: {0X03B3},
+	0X1D71F: {0X03B4},
+	0X1D720: {0X03B5},
+	0X1D721: {0X03B6},
+	0X1D722: {0X03B7},
+	0X1D723: {0X03B8},
+	0X1D724: {0X03B9},
+	0X1D725: {0X03BA},
+	0X1D726: {0X03BB},
+	0X1D727: {0X03BC},
+	0X1D728: {0X03BD},
+	0X1D729: {0X03BE},
+	0X1D72A: {0X03BF},
+	0X1D72B: {0X03C0},
+	0X1D72C: {0X03C1},
+	0X1D72D: {0X03B8},
+	0X1D72E: {0X03C3},
+	0X1D72F: {0X03C4},
+	0X1D730: {0X03C5},
+	0X1D731: {0X03C6},
+	0X1D732: {0X03C7},
+	0X1D733: {0X03C8},
+	0X1D734: {0X03C9},
+	0X1D747: {0X03C3},
+	0X1D756: {0X03B1},
+	0X1D757: {0X03B2},
+	0X1D758: {0X03B3},
+	0X1D759: {0X03B4},
+	0X1D75A: {0X03B5},
+	0X1D75B: {0X03B6},
+	0X1D75C: {0X03B7},
+	0X1D75D: {0X03B8},
+	0X1D75E: {0X03B9},
+	0X1D75F: {0X03BA},
+	0X1D760: {0X03BB},
+	0X1D761: {0X03BC},
+	0X1D762: {0X03BD},
+	0X1D763: {0X03BE},
+	0X1D764: {0X03BF},
+	0X1D765: {0X03C0},
+	0X1D766: {0X03C1},
+	0X1D767: {0X03B8},
+	0X1D768: {0X03C3},
+	0X1D769: {0X03C4},
+	0X1D76A: {0X03C5},
+	0X1D76B: {0X03C6},
+	0X1D76C: {0X03C7},
+	0X1D76D: {0X03C8},
+	0X1D76E: {0X03C9},
+	0X1D781: {0X03C3},
+	0X1D790: {0X03B1},
+	0X1D791: {0X03B2},
+	0X1D792: {0X03B3},
+	0X1D793: {0X03B4},
+	0X1D794: {0X03B5},
+	0X1D795: {0X03B6},
+	0X1D796: {0X03B7},
+	0X1D797: {0X03B8},
+	0X1D798: {0X03B9},
+	0X1D799: {0X03BA},
+	0X1D79A: {0X03BB},
+	0X1D79B: {0X03BC},
+	0X1D79C: {0X03BD},
+	0X1D79D: {0X03BE},
+	0X1D79E: {0X03BF},
+	0X1D79F: {0X03C0},
+	0X1D7A0: {0X03C1},
+	0X1D7A1: {0X03B8},
+	0X1D7A2: {0X03C3},
+	0X1D7A3: {0X03C4},
+	0X1D7A4: {0X03C5},
+	0X1D7A5: {0X03C6},
+	0X1D7A6: {0X03C7},
+	0X1D7A7: {0X03C8},
+	0X1D7A8: {0X03C9},
+	0X1D7BB: {0X03C3},
+}
+
+//TableC11 is the ASCII space characters.
+//https://tools.ietf.org/html/rfc3454#appendix-C.1.1
+var TableC11 = Set{
+	{0X0020, 0X0020},
+}
+
+//TableC12 is the non-ASCII space characters.
+//https://tools.ietf.org/html/rfc3454#appendix-C.1.2
+var TableC12 = Set{
+	{0X00A0, 0X00A0},
+	{0X1680, 0X1680},
+	{0X2000, 0X200B},
+	{0X202F, 0X202F},
+	{0X205F, 0X205F},
+	{0X3000, 0X3000},
+}
+
+//TableC21 is the ASCII control characters.
+//https://tools.ietf.org/html/rfc3454#appendix-C.2.1
+var TableC21 = Set{
+	{0X0000, 0X001F},
+	{0X007F, 0X007F},
+}
+
+//TableC22 is the non-ASCII control characters.
+//https://tools.ietf.org/html/rfc3454#appendix-C.2.2
+var TableC22 = Set{
+	{0X0080, 0X009F},
+	{0X06DD, 0X06DD},
+	{0X070F, 0X070F},
+	{0X180E, 0X180E},
+	{0X200C, 0X200D},
+	{0X2028, 0X2029},
+	{0X2060, 0X2063},
+	{0X206A, 0X206F},
+	{0XFEFF, 0XFEFF},
+	{0XFFF9, 0XFFFC},
+	{0X1D173, 0X1D17A},
+}
+
+//TableC3 is the private use code points.
+//https://tools.ietf.org/html/rfc3454#appendix-C.3
+var TableC3 = Set{
+	{0XE000, 0XF8FF},
+	{0XF0000, 0XFFFFD},
+	{0X100000, 0X10FFFD},
+}
+
+//TableC4 is the non-character code points.
+//https://tools.ietf.org/html/rfc3454#appendix-C.4
+var TableC4 = Set{
+	{0XFDD0, 0XFDEF},
+	{0XFFFE, 0XFFFF},
+	{0X1FFFE, 0X1FFFF},
+	{0X2FFFE, 0X2FFFF},
+	{0X3FFFE, 0X3FFFF},
+	{0X4FFFE, 0X4FFFF},
+	{0X5FFFE, 0X5FFFF},
+	{0X6FFFE, 0X6FFFF},
+	{0X7FFFE, 0X7FFFF},
+	{0X8FFFE, 0X8FFFF},
+	{0X9FFFE, 0X9FFFF},
+	{0XAFFFE, 0XAFFFF},
+	{0XBFFFE, 0XBFFFF},
+	{0XCFFFE, 0XCFFFF},
+	{0XDFFFE, 0XDFFFF},
+	{0XEFFFE, 0XEFFFF},
+	{0XFFFFE, 0XFFFFF},
+	{0X10FFFE, 0X10FFFF},
+}
+
+//TableC5 is the surrogate codes.
+//https://tools.ietf.org/html/rfc3454#appendix-C.5
+var TableC5 = Set{
+	{0XD800, 0XDFFF},
+}
+
+//TableC6 is the inappropriate for plain text code points.
+//https://tools.ietf.org/html/rfc3454#appendix-C.6
+var TableC6 = Set{
+	{0XFFF9, 0XFFFD},
+}
+
+//TableC7 is the inappropriate for canonical representation code points.
+//https://tools.ietf.org/html/rfc3454#appendix-C.7
+var TableC7 = Set{
+	{0X2FF0, 0X2FFB},
+}
+
+//TableC8 is the change display properties or deprecated code points.
+//https://tools.ietf.org/html/rfc3454#appendix-C.8
+var TableC8 = Set{
+	{0X0340, 0X0340},
+	{0X0341, 0X0341},
+	{0X200E, 0X200E},
+	{0X200F, 0X200F},
+	{0X202A, 0X202A},
+	{0X202B, 0X202B},
+	{0X202C, 0X202C},
+	{0X202D, 0X202D},
+	{0X202E, 0X202E},
+	{0X206A, 0X206A},
+	{0X206B, 0X206B},
+	{0X206C, 0X206C},
+	{0X206D, 0X206D},
+	{0X206E, 0X206E},
+	{0X206F, 0X206F},
+}
+
+//TableC9 is the tagging characters.
+//https://tools.ietf.org/html/rfc3454#appendix-C.9
+var TableC9 = Set{
+	{0XE0001, 0XE0001},
+	{0XE0020, 0XE007F},
+}
+
+//TableD1 is the characters with bidirectional property R or AL.
+//https://tools.ietf.org/html/rfc3454#appendix-D.1
+var TableD1 = Set{
+	{0X05BE, 0X05BE},
+	{0X05C0, 0X05C0},
+	{0X05C3, 0X05C3},
+	{0X05D0, 0X05EA},
+	{0X05F0, 0X05F4},
+	{0X061B, 0X061B},
+	{0X061F, 0X061F},
+	{0X0621, 0X063A},
+	{0X0640, 0X064A},
+	{0X066D, 0X066F},
+	{0X0671, 0X06D5},
+	{0X06DD, 0X06DD},
+	{0X06E5, 0X06E6},
+	{0X06FA, 0X06FE},
+	{0X0700, 0X070D},
+	{0X0710, 0X0710},
+	{0X0712, 0X072C},
+	{0X0780, 0X07A5},
+	{0X07B1, 0X07B1},
+	{0X200F, 0X200F},
+	{0XFB1D, 0XFB1D},
+	{0XFB1F, 0XFB28},
+	{0XFB2A, 0XFB36},
+	{0XFB38, 0XFB3C},
+	{0XFB3E, 0XFB3E},
+	{0XFB40, 0XFB41},
+	{0XFB43, 0XFB44},
+	{0XFB46, 0XFBB1},
+	{0XFBD3, 0XFD3D},
+	{0XFD50, 0XFD8F},
+	{0XFD92, 0XFDC7},
+	{0XFDF0, 0XFDFC},
+	{0XFE70, 0XFE74},
+	{0XFE76, 0XFEFC},
+}
+
+//TableD2 is the characters with bidirectional property L.
+//It is derived from the bidirectional classes of the code points which are assigned in Unicode 3.2, that is not in TableA1.
+//https://tools.ietf.org/html/rfc3454#appendix-D.2
+var TableD2 = Set{
+	{0X0041, 0X005A},
+	{0X0061, 0X007A},
+	{0X00AA, 0X00AA},
+	{0X00B5, 0X00B5},
+	{0X00BA, 0X00BA},
+	{0X00C0, 0X00D6},
+	{0X00D8, 0X00F6},
+	{0X00F8, 0X0220},
+	{0X0222, 0X0233},
+	{0X0250, 0X02AD},
+	{0X02B0, 0X02B8},
+	{0X02BB, 0X02C1},
+	{0X02D0, 0X02D1},
+	{0X02E0, 0X02E4},
+	{0X02EE, 0X02EE},
+	{0X037A, 0X037A},
+	{0X0386, 0X0386},
+	{0X0388, 0X038A},
+	{0X038C, 0X038C},
+	{0X038E, 0X03A1},
+	{0X03A3, 0X03CE},
+	{0X03D0, 0X03F5},
+	{0X0400, 0X0482},
+	{0X048A, 0X04CE},
+	{0X04D0, 0X04F5},
+	{0X04F8, 0X04F9},
+	{0X0500, 0X050F},
+	{0X0531, 0X0556},
+	{0X0559, 0X055F},
+	{0X0561, 0X0587},
+	{0X0589, 0X0589},
+	{0X0903, 0X0903},
+	{0X0905, 0X0939},
+	{0X093D, 0X0940},
+	{0X0949, 0X094C},
+	{0X0950, 0X0950},
+	{0X0958, 0X0961},
+	{0X0964, 0X0970},
+	{0X0982, 0X0983},
+	{0X0985, 0X098C},
+	{0X098F, 0X0990},
+	{0X0993, 0X09A8},
+	{0X09AA, 0X09B0},
+	{0X09B2, 0X09B2},
+	{0X09B6, 0X09B9},
+	{0X09BE, 0X09C0},
+	{0X09C7, 0X09C8},
+	{0X09CB, 0X09CC},
+	{0X09D7, 0X09D7},
+	{0X09DC, 0X09DD},
+	{0X09DF, 0X09E1},
+	{0X09E6, 0X09F1},
+	{0X09F4, 0X09FA},
+	{0X0A05, 0X0A0A},
+	{0X0A0F, 0X0A10},
+	{0X0A13, 0X0A28},
+	{0X0A2A, 0X0A30},
+	{0X0A32, 0X0A33},
+	{0X0A35, 0X0A36},
+	{0X0A38, 0X0A39},
+	{0X0A3E, 0X0A40},
+	{0X0A59, 0X0A5C},
+	{0X0A5E, 0X0A5E},
+	{0X0A66, 0X0A6F},
+	{0X0A72, 0X0A74},
+	{0X0A83, 0X0A83},
+	{0X0A85, 0X0A8B},
+	{0X0A8D, 0X0A8D},
+	{0X0A8F, 0X0A91},
+	{0X0A93, 0X0AA8},
+	{0X0AAA, 0X0AB0},
+	{0X0AB2, 0X0AB3},
+	{0X0AB5, 0X0AB9},
+	{0X0ABD, 0X0AC0},
+	{0X0AC9, 0X0AC9},
+	{0X0ACB, 0X0ACC},
+	{0X0AD0, 0X0AD0},
+	{0X0AE0, 0X0AE0},
+	{0X0AE6, 0X0AEF},
+	{0X0B02, 0X0B03},
+	{0X0B05, 0X0B0C},
+	{0X0B0F, 0X0B10},
+	{0X0B13, 0X0B28},
+	{0X0B2A, 0X0B30},
+	{0X0B32, 0X0B33},
+	{0X0B36, 0X0B39},
+	{0X0B3D, 0X0B3E},
+	{0X0B40, 0X0B40},
+	{0X0B47, 0X0B48},
+	{0X0B4B, 0X0B4C},
+	{0X0B57, 0X0B57},
+	{0X0B5C, 0X0B5D},
+	{0X0B5F, 0X0B61},
+	{0X0B66, 0X0B70},
+	{0X0B83, 0X0B83},
+	{0X0B85, 0X0B8A},
+	{0X0B8E, 0X0B90},
+	{0X0B92, 0X0B95},
+	{0X0B99, 0X0B9A},
+	{0X0B9C, 0X0B9C},
+	{0X0B9E, 0X0B9F},
+	{0X0BA3, 0X0BA4},
+	{0X0BA8, 0X0BAA},
+	{0X0BAE, 0X0BB5},
+	{0X0BB7, 0X0BB9},
+	{0X0BBE, 0X0BBF},
+	{0X0BC1, 0X0BC2},
+	{0X0BC6, 0X0BC8},
+	{0X0BCA, 0X0BCC},
+	{0X0BD7, 0X0BD7},
+	{0X0BE7, 0X0BF2},
+	{0X0C01, 0X0C03},
+	{0X0C05, 0X0C0C},
+	{0X0C0E, 0X0C10},
+	{0X0C12, 0X0C28},
+	{0X0C2A, 0X0C33},
+	{0X0C35, 0X0C39},
+	{0X0C41, 0X0C44},
+	{0X0C60, 0X0C61},
+	{0X0C66, 0X0C6F},
+	{0X0C82, 0X0C83},
+	{0X0C85, 0X0C8C},
+	{0X0C8E, 0X0C90},
+	{0X0C92, 0X0CA8},
+	{0X0CAA, 0X0CB3},
+	{0X0CB5, 0X0CB9},
+	{0X0CBE, 0X0CC4},
+	{0X0CC6, 0X0CC8},
+	{0X0CCA, 0X0CCB},
+	{0X0CD5, 0X0CD6},
+	{0X0CDE, 0X0CDE},
+	{0X0CE0, 0X0CE1},
+	{0X0CE6, 0X0CEF},
+	{0X0D02, 0X0D03},
+	{0X0D05, 0X0D0C},
+	{0X0D0E, 0X0D10},
+	{0X0D12, 0X0D28},
+	{0X0D2A, 0X0D39},
+	{0X0D3E, 0X0D40},
+	{0X0D46, 0X0D48},
+	{0X0D4A, 0X0D4C},
+	{0X0D57, 0X0D57},
+	{0X0D60, 0X0D61},
+	{0X0D66, 0X0D6F},
+	{0X0D82, 0X0D83},
+	{0X0D85, 0X0D96},
+	{0X0D9A, 0X0DB1},
+	{0X0DB3, 0X0DBB},
+	{0X0DBD, 0X0DBD},
+	{0X0DC0, 0X0DC6},
+	{0X0DCF, 0X0DD1},
+	{0X0DD8, 0X0DDF},
+	{0X0DF2, 0X0DF4},
+	{0X0E01, 0X0E30},
+	{0X0E32, 0X0E33},
+	{0X0E40, 0X0E46},
+	{0X0E4F, 0X0E5B},
+	{0X0E81, 0X0E82},
+	{0X0E84, 0X0E84},
+	{0X0E87, 0X0E88},
+	{0X0E8A, 0X0E8A},
+	{0X0E8D, 0X0E8D},
+	{0X0E94, 0X0E97},
+	{0X0E99, 0X0E9F},
+	{0X0EA1, 0X0EA3},
+	{0X0EA5, 0X0EA5},
+	{0X0EA7, 0X0EA7},
+	{0X0EAA, 0X0EAB},
+	{0X0EAD, 0X0EB0},
+	{0X0EB2, 0X0EB3},
+	{0X0EBD, 0X0EBD},
+	{0X0EC0, 0X0EC4},
+	{0X0EC6, 0X0EC6},
+	{0X0ED0, 0X0ED9},
+	{0X0EDC, 0X0EDD},
+	{0X0F00, 0X0F17},
+	{0X0F1A, 0X0F34},
+	{0X0F36, 0X0F36},
+	{0X0F38, 0X0F38},
+	{0X0F3E, 0X0F47},
+	{0X0F49, 0X0F6A},
+	{0X0F7F, 0X0F7F},
+	{0X0F85, 0X0F85},
+	{0X0F88, 0X0F8B},
+	{0X0FBE, 0X0FC5},
+	{0X0FC7, 0X0FCC},
+	{0X0FCF, 0X0FCF},
+	{0X1000, 0X1021},
+	{0X1023, 0X1027},
+	{0X1029, 0X102A},
+	{0X102C, 0X102C},
+	{0X1031, 0X1031},
+	{0X1038, 0X1038},
+	{0X1040, 0X1057},
+	{0X10A0, 0X10C5},
+	{0X10D0, 0X10F8},
+	{0X10FB, 0X10FB},
+	{0X1100, 0X1159},
+	{0X115F, 0X11A2},
+	{0X11A8, 0X11F9},
+	{0X1200, 0X1206},
+	{0X1208, 0X1246},
+	{0X1248, 0X1248},
+	{0X124A, 0X124D},
+	{0X1250, 0X1256},
+	{0X1258, 0X1258},
+	{0X125A, 0X125D},
+	{0X1260, 0X1286},
+	{0X1288, 0X1288},
+	{0X128A, 0X128D},
+	{0X1290, 0X12AE},
+	{0X12B0, 0X12B0},
+	{0X12B2, 0X12B5},
+	{0X12B8, 0X12BE},
+	{0X12C0, 0X12C0},
+	{0X12C2, 0X12C5},
+	{0X12C8, 0X12CE},
+	{0X12D0, 0X12D6},
+	{0X12D8, 0X12EE},
+	{0X12F0, 0X130E},
+	{0X1310, 0X1310},
+	{0X1312, 0X1315},
+	{0X1318, 0X131E},
+	{0X1320, 0X1346},
+	{0X1348, 0X135A},
+	{0X1361, 0X137C},
+	{0X13A0, 0X13F4},
+	{0X1401, 0X1676},
+	{0X1681, 0X169A},
+	{0X16A0, 0X16F0},
+	{0X1700, 0X170C},
+	{0X170E, 0X1711},
+	{0X1720, 0X1731},
+	{0X1734, 0X1736},
+	{0X1740, 0X1751},
+	{0X1760, 0X176C},
+	{0X176E, 0X1770},
+	{0X1780, 0X17B3},
+	{0X17B6, 0X17B6},
+	{0X17BE, 0X17C5},
+	{0X17C7, 0X17C8},
+	{0X17D4, 0X17DA},
+	{0X17DC, 0X17DC},
+	{0X17E0, 0X17E9},
+	{0X1810, 0X1819},
+	{0X1820, 0X1877},
+	{0X1880, 0X1884},
+	{0X1887, 0X18A8},
+	{0X1E00, 0X1E9B},
+	{0X1EA0, 0X1EF9},
+	{0X1F00, 0X1F15},
+	{0X1F18, 0X1F1D},
+	{0X1F20, 0X1F45},
+	{0X1F48, 0X1F4D},
+	{0X1F50, 0X1F57},
+	{0X1F59, 0X1F59},
+	{0X1F5B, 0X1F5B},
+	{0X1F5D, 0X1F5D},
+	{0X1F5F, 0X1F7D},
+	{0X1F80, 0X1FB4},
+	{0X1FB6, 0X1FBC},
+	{0X1FBE, 0X1FBE},
+	{0X1FC2, 0X1FC4},
+	{0X1FC6, 0X1FCC},
+	{0X1FD0, 0X1FD3},
+	{0X1FD6, 0X1FDB},
+	{0X1FE0, 0X1FEC},
+	{0X1FF2, 0X1FF4},
+	{0X1FF6, 0X1FFC},
+	{0X200E, 0X200E},
+	{0X2071, 0X2071},
+	{0X207F, 0X207F},
+	{0X2102, 0X2102},
+	{0X2107, 0X2107},
+	{0X210A, 0X2113},
+	{0X2115, 0X2115},
+	{0X2119, 0X211D},
+	{0X2124, 0X2124},
+	{0X2126, 0X2126},
+	{0X2128, 0X2128},
+	{0X212A, 0X212D},
+	{0X212F, 0X2139},
+	{0X213D, 0X213F},
+	{0X2145, 0X2149},
+	{0X2160, 0X2183},
+	{0X2336, 0X237A},
+	{0X2395, 0X2395},
+	{0X249C, 0X24E9},
+	{0X2800, 0X28FF},
+	{0X3005, 0X3007},
+	{0X3021, 0X3029},
+	{0X302E, 0X302F},
+	{0X3031, 0X3035},
+	{0X3038, 0X303C},
+	{0X3041, 0X3096},
+	{0X309D, 0X309F},
+	{0X30A1, 0X30FA},
+	{0X30FC, 0X30FF},
+	{0X3105, 0X312C},
+	{0X3131, 0X318E},
+	{0X3190, 0X31B7},
+	{0X31F0, 0X321C},
+	{0X3220, 0X3243},
+	{0X3260, 0X327B},
+	{0X327F, 0X32B0},
+	{0X32C0, 0X32CB},
+	{0X32D0, 0X32FE},
+	{0X3300, 0X3376},
+	{0X337B, 0X33DD},
+	{0X33E0, 0X33FE},
+	{0X3400, 0X4DB5},
+	{0X4E00, 0X9FA5},
+	{0XA000, 0XA48C},
+	{0XAC00, 0XD7A3},
+	{0XE000, 0XFA2D},
+	{0XFA30, 0XFA6A},
+	{0XFB00, 0XFB06},
+	{0XFB13, 0XFB17},
+	{0XFF21, 0XFF3A},
+	{0XFF41, 0XFF5A},
+	{0XFF66, 0XFFBE},
+	{0XFFC2, 0XFFC7},
+	{0XFFCA, 0XFFCF},
+	{0XFFD2, 0XFFD7},
+	{0XFFDA, 0XFFDC},
+	{0X10300, 0X1031E},
+	{0X10320, 0X10323},
+	{0X10330, 0X1034A},
+	{0X10400, 0X10425},
+	{0X10428, 0X1044D},
+	{0X1D000, 0X1D0F5},
+	{0X1D100, 0X1D126},
+	{0X1D12A, 0X1D166},
+	{0X1D16A, 0X1D172},
+	{0X1D183, 0X1D184},
+	{0X1D18C, 0X1D1A9},
+	{0X1D1AE, 0X1D1DD},
+	{0X1D400, 0X1D454},
+	{0X1D456, 0X1D49C},
+	{0X1D49E, 0X1D49F},
+	{0X1D4A2, 0X1D4A2},
+	{0X1D4A5, 0X1D4A6},
+	{0X1D4A9, 0X1D4AC},
+	{0X1D4AE, 0X1D4B9},
+	{0X1D4BB, 0X1D4BB},
+	{0X1D4BD, 0X1D4C0},
+	{0X1D4C2, 0X1D4C3},
+	{0X1D4C5, 0X1D505},
+	{0X1D507, 0X1D50A},
+	{0X1D50D, 0X1D514},
+	{0X1D516, 0X1D51C},
+	{0X1D51E, 0X1D539},
+	{0X1D53B, 0X1D53E},
+	{0X1D540, 0X1D544},
+	{0X1D546, 0X1D546},
+	{0X1D54A, 0X1D550},
+	{0X1D552, 0X1D6A3},
+	{0X1D6A8, 0X1D6DA},
+	{0X1D6DC, 0X1D714},
+	{0X1D716, 0X1D74E},
+	{0X1D750, 0X1D788},
+	{0X1D78A, 0X1D7C2},
+	{0X1D7C4, 0X1D7C9},
+	{0X20000, 0X2A6D6},
+	{0X2F800, 0X2FA1D},
+	{0XF0000, 0XFFFFD},
+	{0X100000, 0X10FFFD},
+}
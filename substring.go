@@ -0,0 +1,152 @@
+package ldapstrprep
+
+import "strings"
+
+//SubstringAssertion is a parsed Substring Assertion.
+//Initial and Final are empty if they are absent.
+//https://tools.ietf.org/html/rfc4517#section-3.3.30
+type SubstringAssertion struct {
+	Initial string
+	Any     []string
+	Final   string
+}
+
+//ParseSubstringAssertion parses s as a Substring Assertion, such as "foo*bar*baz".
+//Escaped asterisks (\2A) and backslashes (\5C) are unescaped.
+//https://tools.ietf.org/html/rfc4517#section-3.3.30
+func ParseSubstringAssertion(s string) (*SubstringAssertion, error) {
+	if err := ValidateSubstringAssertion(s); err != nil {
+		return nil, err
+	}
+	parts := strings.Split(s, "*")
+	for i := range parts {
+		parts[i] = unescapeHexPairs(parts[i], "2A", "5C")
+	}
+	a := &SubstringAssertion{Initial: parts[0], Any: make([]string, 0, 0)}
+	if len(parts) > 1 {
+		a.Any = append(a.Any, parts[1:len(parts)-1]...)
+		a.Final = parts[len(parts)-1]
+	}
+	return a, nil
+}
+
+//preparedSubstrings is a SubstringAssertion whose substrings are prepared.
+//initial and final are nil if they are absent.
+type preparedSubstrings struct {
+	initial []rune
+	any     [][]rune
+	final   []rune
+}
+
+//prepareSubstrings prepares the substrings of a and applies Insignificant Space Handling for substrings to them.
+//https://tools.ietf.org/html/rfc4518#section-2.6.1
+func prepareSubstrings(a *SubstringAssertion, caseFolding bool) (*preparedSubstrings, error) {
+	p := &preparedSubstrings{any: make([][]rune, 0, len(a.Any))}
+	if a.Initial != "" {
+		r, err := prepare(a.Initial, caseFolding)
+		if err != nil {
+			return nil, err
+		}
+		p.initial = ApplyInsignificantSpaceHandlingInitial(r)
+	}
+	for _, s := range a.Any {
+		r, err := prepare(s, caseFolding)
+		if err != nil {
+			return nil, err
+		}
+		p.any = append(p.any, ApplyInsignificantSpaceHandlingAny(r))
+	}
+	if a.Final != "" {
+		r, err := prepare(a.Final, caseFolding)
+		if err != nil {
+			return nil, err
+		}
+		p.final = ApplyInsignificantSpaceHandlingFinal(r)
+	}
+	return p, nil
+}
+
+//matchSubstrings reports whether the prepared substrings p match the prepared strings values.
+//values are matched as if they were concatenated, but a substring never matches across two values.
+//https://tools.ietf.org/html/rfc4517#section-4.2.10
+func matchSubstrings(values [][]rune, p *preparedSubstrings) bool {
+	if len(values) == 0 {
+		return false
+	}
+	strs := make([]string, len(values))
+	for i := range values {
+		strs[i] = string(values[i])
+	}
+	//line and pos are the position where the next substring may start.
+	line, pos := 0, 0
+	if p.initial != nil {
+		if !strings.HasPrefix(strs[0], string(p.initial)) {
+			return false
+		}
+		pos = len(string(p.initial))
+	}
+	for _, r := range p.any {
+		sub := string(r)
+		for {
+			if line >= len(strs) {
+				return false
+			}
+			if i := strings.Index(strs[line][pos:], sub); i >= 0 {
+				pos += i + len(sub)
+				break
+			}
+			line, pos = line+1, 0
+		}
+	}
+	if p.final != nil {
+		last := strs[len(strs)-1]
+		final := string(p.final)
+		if line == len(strs)-1 && len(last)-pos < len(final) {
+			return false
+		}
+		return strings.HasSuffix(last, final)
+	}
+	return true
+}
+
+//unescapeHexPairs replaces the escape sequences of the hex pairs in s, such as \5C, with the characters they represent.
+//Hex pairs are case-insensitive.
+func unescapeHexPairs(s string, hexPairs ...string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' {
+			if c, ok := matchHexPair(s[i+1:], hexPairs); ok {
+				b.WriteByte(c)
+				i += 2
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+//matchHexPair returns the character which is represented by the hex pair at the beginning of s, if it is one of hexPairs.
+func matchHexPair(s string, hexPairs []string) (byte, bool) {
+	for _, h := range hexPairs {
+		if hasEscapedHexPair(s, h) {
+			return unhex(h[0])<<4 | unhex(h[1]), true
+		}
+	}
+	return 0, false
+}
+
+//unhex returns the value of the hexadecimal digit c.
+func unhex(c byte) byte {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0'
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10
+	default:
+		return c - 'A' + 10
+	}
+}
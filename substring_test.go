@@ -0,0 +1,77 @@
+package ldapstrprep
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSubstringAssertion(t *testing.T) {
+	type args struct {
+		s string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *SubstringAssertion
+		wantErr bool
+	}{
+		{"TestCase:initial", args{"foo*"}, &SubstringAssertion{Initial: "foo", Any: []string{}}, false},
+		{"TestCase:any", args{"*foo*"}, &SubstringAssertion{Any: []string{"foo"}}, false},
+		{"TestCase:STAR only", args{"*"}, &SubstringAssertion{Any: []string{}}, false},
+		{"TestCase:final", args{"*foo"}, &SubstringAssertion{Any: []string{}, Final: "foo"}, false},
+		{"TestCase:initial and final", args{"foo*bar"}, &SubstringAssertion{Initial: "foo", Any: []string{}, Final: "bar"}, false},
+		{"TestCase:initial, any and final", args{"foo*bar*baz*qux"}, &SubstringAssertion{Initial: "foo", Any: []string{"bar", "baz"}, Final: "qux"}, false},
+		{"TestCase:any and final", args{"*bar*baz"}, &SubstringAssertion{Any: []string{"bar"}, Final: "baz"}, false},
+		{"TestCase:escapes", args{`a\2Ab*\5c`}, &SubstringAssertion{Initial: "a*b", Any: []string{}, Final: `\`}, false},
+		{"TestCase:no STAR", args{"foo"}, nil, true},
+		{"TestCase:empty", args{""}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseSubstringAssertion(tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseSubstringAssertion() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSubstringAssertion() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_matchSubstrings(t *testing.T) {
+	type args struct {
+		values []string
+		p      *preparedSubstrings
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"TestCase:initial", args{[]string{" foo  bar "}, &preparedSubstrings{initial: []rune(" foo")}}, true},
+		{"TestCase:initial mismatch", args{[]string{" foo  bar "}, &preparedSubstrings{initial: []rune(" bar")}}, false},
+		{"TestCase:final", args{[]string{" foo  bar "}, &preparedSubstrings{final: []rune("bar ")}}, true},
+		{"TestCase:any", args{[]string{" foo  bar "}, &preparedSubstrings{any: [][]rune{[]rune("o  b")}}}, true},
+		{"TestCase:any in order", args{[]string{" abcabc "}, &preparedSubstrings{any: [][]rune{[]rune("c"), []rune("a"), []rune("c")}}}, true},
+		{"TestCase:any out of order", args{[]string{" abc "}, &preparedSubstrings{any: [][]rune{[]rune("c"), []rune("a")}}}, false},
+		{"TestCase:initial and final overlap", args{[]string{" abc "}, &preparedSubstrings{initial: []rune(" ab"), final: []rune("bc ")}}, false},
+		{"TestCase:any and final overlap", args{[]string{" abc "}, &preparedSubstrings{any: [][]rune{[]rune("bc")}, final: []rune("c ")}}, false},
+		{"TestCase:any across values", args{[]string{" foo ", " bar "}, &preparedSubstrings{any: [][]rune{[]rune("o  b")}}}, false},
+		{"TestCase:any in second value", args{[]string{" foo ", " bar "}, &preparedSubstrings{initial: []rune(" f"), any: [][]rune{[]rune("a")}, final: []rune("r ")}}, true},
+		{"TestCase:initial and final in different values", args{[]string{" abc ", " abc "}, &preparedSubstrings{initial: []rune(" ab"), final: []rune("bc ")}}, true},
+		{"TestCase:no values", args{[]string{}, &preparedSubstrings{}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make([][]rune, 0, 0)
+			for _, v := range tt.args.values {
+				values = append(values, []rune(v))
+			}
+			if got := matchSubstrings(values, tt.args.p); got != tt.want {
+				t.Errorf("matchSubstrings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
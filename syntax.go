@@ -0,0 +1,239 @@
+package ldapstrprep
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+//Object identifiers of the RFC 4517 syntaxes which are validated by this package.
+//https://tools.ietf.org/html/rfc4517#section-3.3
+const (
+	CountryStringSyntax            = "1.3.6.1.4.1.1466.115.121.1.11"
+	DirectoryStringSyntax          = "1.3.6.1.4.1.1466.115.121.1.15"
+	FacsimileTelephoneNumberSyntax = "1.3.6.1.4.1.1466.115.121.1.22"
+	IA5StringSyntax                = "1.3.6.1.4.1.1466.115.121.1.26"
+	NumericStringSyntax            = "1.3.6.1.4.1.1466.115.121.1.36"
+	PostalAddressSyntax            = "1.3.6.1.4.1.1466.115.121.1.41"
+	PrintableStringSyntax          = "1.3.6.1.4.1.1466.115.121.1.44"
+	SubstringAssertionSyntax       = "1.3.6.1.4.1.1466.115.121.1.58"
+	TelephoneNumberSyntax          = "1.3.6.1.4.1.1466.115.121.1.50"
+)
+
+//SyntaxError is the error which reports that a value is not valid for a RFC 4517 syntax.
+//Offset is the byte offset of the invalid character in Value, or -1 if Value is invalid as a whole.
+type SyntaxError struct {
+	Syntax string
+	Value  string
+	Offset int
+	Reason string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Offset < 0 {
+		return fmt.Sprintf("ldapstrprep: invalid %s %q: %s", e.Syntax, e.Value, e.Reason)
+	}
+	return fmt.Sprintf("ldapstrprep: invalid %s %q: %s at offset %d", e.Syntax, e.Value, e.Reason, e.Offset)
+}
+
+//syntaxValidators maps the object identifiers of syntaxes to their validators.
+var syntaxValidators = map[string]func(string) error{
+	CountryStringSyntax:            ValidateCountryString,
+	DirectoryStringSyntax:          ValidateDirectoryString,
+	FacsimileTelephoneNumberSyntax: ValidateFacsimileTelephoneNumber,
+	IA5StringSyntax:                ValidateIA5String,
+	NumericStringSyntax:            ValidateNumericString,
+	PostalAddressSyntax:            ValidatePostalAddress,
+	PrintableStringSyntax:          ValidatePrintableString,
+	SubstringAssertionSyntax:       ValidateSubstringAssertion,
+	TelephoneNumberSyntax:          ValidateTelephoneNumber,
+}
+
+//ValidateSyntax validates s as a value of the syntax identified by oid, such as the Syntax of an AttributeTypeDescription.
+//Values of syntaxes which are not validated by this package are always valid.
+func ValidateSyntax(oid string, s string) error {
+	if validate, ok := syntaxValidators[oid]; ok {
+		return validate(s)
+	}
+	return nil
+}
+
+//ValidateDirectoryString validates s as a Directory String.
+//https://tools.ietf.org/html/rfc4517#section-3.3.6
+func ValidateDirectoryString(s string) error {
+	const syntax = "Directory String"
+	if s == "" {
+		return &SyntaxError{Syntax: syntax, Value: s, Offset: -1, Reason: "empty value"}
+	}
+	return validateUTF8(syntax, s)
+}
+
+//ValidateIA5String validates s as an IA5 String.
+//https://tools.ietf.org/html/rfc4517#section-3.3.15
+func ValidateIA5String(s string) error {
+	for i := 0; i < len(s); i++ {
+		if s[i] > 0X7F {
+			return &SyntaxError{Syntax: "IA5 String", Value: s, Offset: i, Reason: "non-IA5 character"}
+		}
+	}
+	return nil
+}
+
+//ValidatePrintableString validates s as a Printable String.
+//https://tools.ietf.org/html/rfc4517#section-3.3.29
+func ValidatePrintableString(s string) error {
+	return validatePrintableString("Printable String", s, s)
+}
+
+//ValidateNumericString validates s as a Numeric String.
+//https://tools.ietf.org/html/rfc4517#section-3.3.23
+func ValidateNumericString(s string) error {
+	const syntax = "Numeric String"
+	if s == "" {
+		return &SyntaxError{Syntax: syntax, Value: s, Offset: -1, Reason: "empty value"}
+	}
+	for i := 0; i < len(s); i++ {
+		if !(s[i] >= '0' && s[i] <= '9') && s[i] != ' ' {
+			return &SyntaxError{Syntax: syntax, Value: s, Offset: i, Reason: "neither digit nor space"}
+		}
+	}
+	return nil
+}
+
+//ValidateTelephoneNumber validates s as a Telephone Number.
+//https://tools.ietf.org/html/rfc4517#section-3.3.31
+func ValidateTelephoneNumber(s string) error {
+	return validatePrintableString("Telephone Number", s, s)
+}
+
+//ValidateCountryString validates s as a Country String.
+//https://tools.ietf.org/html/rfc4517#section-3.3.4
+func ValidateCountryString(s string) error {
+	const syntax = "Country String"
+	if len(s) != 2 {
+		return &SyntaxError{Syntax: syntax, Value: s, Offset: -1, Reason: "not two characters"}
+	}
+	return validatePrintableString(syntax, s, s)
+}
+
+//ValidatePostalAddress validates s as a Postal Address.
+//https://tools.ietf.org/html/rfc4517#section-3.3.28
+func ValidatePostalAddress(s string) error {
+	const syntax = "Postal Address"
+	if err := validateUTF8(syntax, s); err != nil {
+		return err
+	}
+	start := 0
+	for i := 0; i <= len(s); i++ {
+		if i == len(s) || s[i] == '$' {
+			if i == start {
+				return &SyntaxError{Syntax: syntax, Value: s, Offset: i, Reason: "empty line"}
+			}
+			start = i + 1
+			continue
+		}
+		if s[i] == '\\' {
+			if !hasEscapedHexPair(s[i+1:], "24") && !hasEscapedHexPair(s[i+1:], "5C") {
+				return &SyntaxError{Syntax: syntax, Value: s, Offset: i, Reason: "invalid escape sequence"}
+			}
+			i += 2
+		}
+	}
+	return nil
+}
+
+//ValidateFacsimileTelephoneNumber validates s as a Facsimile Telephone Number.
+//https://tools.ietf.org/html/rfc4517#section-3.3.11
+func ValidateFacsimileTelephoneNumber(s string) error {
+	const syntax = "Facsimile Telephone Number"
+	fields := strings.Split(s, "$")
+	if err := validatePrintableString(syntax, s, fields[0]); err != nil {
+		return err
+	}
+	offset := len(fields[0]) + 1
+	for _, p := range fields[1:] {
+		switch p {
+		case "twoDimensional", "fineResolution", "unlimitedLength", "b4Length", "a3Width", "b4Width", "uncompressed":
+		default:
+			return &SyntaxError{Syntax: syntax, Value: s, Offset: offset, Reason: "unknown fax parameter"}
+		}
+		offset += len(p) + 1
+	}
+	return nil
+}
+
+//ValidateSubstringAssertion validates s as a Substring Assertion.
+//https://tools.ietf.org/html/rfc4517#section-3.3.30
+func ValidateSubstringAssertion(s string) error {
+	const syntax = "Substring Assertion"
+	if s == "" {
+		return &SyntaxError{Syntax: syntax, Value: s, Offset: -1, Reason: "empty value"}
+	}
+	if err := validateUTF8(syntax, s); err != nil {
+		return err
+	}
+	hasStar := false
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '*':
+			//Substrings between STARs have at least one character.
+			if i+1 < len(s) && s[i+1] == '*' {
+				return &SyntaxError{Syntax: syntax, Value: s, Offset: i, Reason: "empty substring"}
+			}
+			hasStar = true
+		case '\\':
+			if !hasEscapedHexPair(s[i+1:], "2A") && !hasEscapedHexPair(s[i+1:], "5C") {
+				return &SyntaxError{Syntax: syntax, Value: s, Offset: i, Reason: "invalid escape sequence"}
+			}
+			i += 2
+		}
+	}
+	if !hasStar {
+		return &SyntaxError{Syntax: syntax, Value: s, Offset: -1, Reason: "no asterisk"}
+	}
+	return nil
+}
+
+//validatePrintableString validates s, which is the beginning of value, as a PrintableString of syntax.
+//https://tools.ietf.org/html/rfc4517#section-3.2
+func validatePrintableString(syntax string, value string, s string) error {
+	if s == "" {
+		return &SyntaxError{Syntax: syntax, Value: value, Offset: -1, Reason: "empty value"}
+	}
+	for i := 0; i < len(s); i++ {
+		if !isPrintableCharacter(s[i]) {
+			return &SyntaxError{Syntax: syntax, Value: value, Offset: i, Reason: "non-printable character"}
+		}
+	}
+	return nil
+}
+
+//validateUTF8 validates that s is a valid UTF-8 string.
+func validateUTF8(syntax string, s string) error {
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			return &SyntaxError{Syntax: syntax, Value: s, Offset: i, Reason: "invalid UTF-8"}
+		}
+		i += size
+	}
+	return nil
+}
+
+//isPrintableCharacter reports whether c is a PrintableCharacter.
+//https://tools.ietf.org/html/rfc4517#section-3.2
+func isPrintableCharacter(c byte) bool {
+	switch {
+	case c >= 'A' && c <= 'Z', c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+		return true
+	case strings.IndexByte("'()+,-./:=? ", c) >= 0:
+		return true
+	default:
+		return false
+	}
+}
+
+//hasEscapedHexPair reports whether s starts with hex, case-insensitively.
+func hasEscapedHexPair(s string, hex string) bool {
+	return len(s) >= len(hex) && strings.EqualFold(s[:len(hex)], hex)
+}
@@ -0,0 +1,81 @@
+package ldapstrprep
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateSyntax(t *testing.T) {
+	type args struct {
+		oid string
+		s   string
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantErr    bool
+		wantOffset int
+	}{
+		{"TestCase:Directory String", args{DirectoryStringSyntax, "Ｊｏｈｎ Smith"}, false, 0},
+		{"TestCase:Directory String empty", args{DirectoryStringSyntax, ""}, true, -1},
+		{"TestCase:Directory String invalid UTF-8", args{DirectoryStringSyntax, "ab\xffc"}, true, 2},
+		{"TestCase:IA5 String", args{IA5StringSyntax, "user@example.com"}, false, 0},
+		{"TestCase:IA5 String empty", args{IA5StringSyntax, ""}, false, 0},
+		{"TestCase:IA5 String non-ASCII", args{IA5StringSyntax, "usér"}, true, 2},
+		{"TestCase:Printable String", args{PrintableStringSyntax, "Acme (Japan), Ltd. 1+2=3?/:'-"}, false, 0},
+		{"TestCase:Printable String empty", args{PrintableStringSyntax, ""}, true, -1},
+		{"TestCase:Printable String @", args{PrintableStringSyntax, "a@b"}, true, 1},
+		{"TestCase:Numeric String", args{NumericStringSyntax, "15 079 672 281"}, false, 0},
+		{"TestCase:Numeric String empty", args{NumericStringSyntax, ""}, true, -1},
+		{"TestCase:Numeric String hyphen", args{NumericStringSyntax, "15-079"}, true, 2},
+		{"TestCase:Telephone Number", args{TelephoneNumberSyntax, "+1 512 315 0280"}, false, 0},
+		{"TestCase:Telephone Number empty", args{TelephoneNumberSyntax, ""}, true, -1},
+		{"TestCase:Telephone Number #", args{TelephoneNumberSyntax, "+1 512 #0280"}, true, 7},
+		{"TestCase:Country String", args{CountryStringSyntax, "JP"}, false, 0},
+		{"TestCase:Country String 3 characters", args{CountryStringSyntax, "JPN"}, true, -1},
+		{"TestCase:Country String non-printable", args{CountryStringSyntax, "J!"}, true, 1},
+		{"TestCase:Postal Address", args{PostalAddressSyntax, `1234 Main St.$Anytown, CA 12345$USA`}, false, 0},
+		{"TestCase:Postal Address escapes", args{PostalAddressSyntax, `\241,000,000 Sweepstakes$PO Box 1000000$Anytown, CA 12345$USA\5c`}, false, 0},
+		{"TestCase:Postal Address empty", args{PostalAddressSyntax, ""}, true, 0},
+		{"TestCase:Postal Address empty line", args{PostalAddressSyntax, "a$$b"}, true, 2},
+		{"TestCase:Postal Address trailing dollar", args{PostalAddressSyntax, "a$"}, true, 2},
+		{"TestCase:Postal Address invalid escape", args{PostalAddressSyntax, `a\2Ab`}, true, 1},
+		{"TestCase:Postal Address truncated escape", args{PostalAddressSyntax, `a\2`}, true, 1},
+		{"TestCase:Facsimile Telephone Number", args{FacsimileTelephoneNumberSyntax, "+81 3 1234 5678"}, false, 0},
+		{"TestCase:Facsimile Telephone Number parameters", args{FacsimileTelephoneNumberSyntax, "+81 3 1234 5678$twoDimensional$b4Width"}, false, 0},
+		{"TestCase:Facsimile Telephone Number unknown parameter", args{FacsimileTelephoneNumberSyntax, "+81 3 1234 5678$twoDimensional$color"}, true, 31},
+		{"TestCase:Facsimile Telephone Number no number", args{FacsimileTelephoneNumberSyntax, "$twoDimensional"}, true, -1},
+		{"TestCase:Substring Assertion initial", args{SubstringAssertionSyntax, "foo*"}, false, 0},
+		{"TestCase:Substring Assertion STAR only", args{SubstringAssertionSyntax, "*"}, false, 0},
+		{"TestCase:Substring Assertion all", args{SubstringAssertionSyntax, "foo*bar*baz"}, false, 0},
+		{"TestCase:Substring Assertion final", args{SubstringAssertionSyntax, "*baz"}, false, 0},
+		{"TestCase:Substring Assertion escapes", args{SubstringAssertionSyntax, `\2a*\5C`}, false, 0},
+		{"TestCase:Substring Assertion empty", args{SubstringAssertionSyntax, ""}, true, -1},
+		{"TestCase:Substring Assertion no STAR", args{SubstringAssertionSyntax, "foo"}, true, -1},
+		{"TestCase:Substring Assertion empty any", args{SubstringAssertionSyntax, "foo**bar"}, true, 3},
+		{"TestCase:Substring Assertion invalid escape", args{SubstringAssertionSyntax, `foo\24`}, true, 3},
+		{"TestCase:unknown syntax", args{"1.3.6.1.4.1.1466.115.121.1.27", "not an integer"}, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSyntax(tt.args.oid, tt.args.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSyntax() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err == nil {
+				return
+			}
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("ValidateSyntax() error = %v, want *SyntaxError", err)
+			}
+			if syntaxErr.Offset != tt.wantOffset {
+				t.Errorf("SyntaxError.Offset = %d, want %d", syntaxErr.Offset, tt.wantOffset)
+			}
+			if syntaxErr.Value != tt.args.s {
+				t.Errorf("SyntaxError.Value = %q, want %q", syntaxErr.Value, tt.args.s)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package ldapstrprep
+
+import (
+	"fmt"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+//t61Undefined marks the code positions of t61UpperTable which are not defined.
+const t61Undefined = -1
+
+//t61UpperTable maps the code positions 0XA0-0XFF of T.61 (ISO/IEC 6937) to Unicode,
+//except the non-spacing diacritical marks 0XC1-0XCF which are defined by t61Diacritics.
+//The code positions 0X00-0X9F are mapped to the same Unicode code points.
+var t61UpperTable = [96]rune{
+	//0XA0-0XAF
+	0X00A0, 0X00A1, 0X00A2, 0X00A3, 0X0024, 0X00A5, 0X0023, 0X00A7,
+	0X00A4, 0X2018, 0X201C, 0X00AB, 0X2190, 0X2191, 0X2192, 0X2193,
+	//0XB0-0XBF
+	0X00B0, 0X00B1, 0X00B2, 0X00B3, 0X00D7, 0X00B5, 0X00B6, 0X00B7,
+	0X00F7, 0X2019, 0X201D, 0X00BB, 0X00BC, 0X00BD, 0X00BE, 0X00BF,
+	//0XC0-0XCF
+	t61Undefined, t61Undefined, t61Undefined, t61Undefined, t61Undefined, t61Undefined, t61Undefined, t61Undefined,
+	t61Undefined, t61Undefined, t61Undefined, t61Undefined, t61Undefined, t61Undefined, t61Undefined, t61Undefined,
+	//0XD0-0XDF
+	0X2015, 0X00B9, 0X00AE, 0X00A9, 0X2122, 0X266A, 0X00AC, 0X00A6,
+	t61Undefined, t61Undefined, t61Undefined, t61Undefined, 0X215B, 0X215C, 0X215D, 0X215E,
+	//0XE0-0XEF
+	0X2126, 0X00C6, 0X0110, 0X00AA, 0X0126, t61Undefined, 0X0132, 0X013F,
+	0X0141, 0X00D8, 0X0152, 0X00BA, 0X00DE, 0X0166, 0X014A, 0X0149,
+	//0XF0-0XFF
+	0X0138, 0X00E6, 0X0111, 0X00F0, 0X0127, 0X0131, 0X0133, 0X0140,
+	0X0142, 0X00F8, 0X0153, 0X00DF, 0X00FE, 0X0167, 0X014B, 0X00AD,
+}
+
+//t61Diacritic is a non-spacing diacritical mark of T.61.
+type t61Diacritic struct {
+	//combining is the combining character of the mark, which follows the base character in Unicode.
+	combining rune
+	//spacing is the character which the mark followed by SPACE represents.
+	spacing rune
+}
+
+//t61Diacritics maps the non-spacing diacritical marks of T.61 (ISO/IEC 6937) to Unicode.
+//In T.61, a non-spacing diacritical mark precedes the base character.
+var t61Diacritics = map[byte]t61Diacritic{
+	0XC1: {0X0300, 0X0060}, //grave accent
+	0XC2: {0X0301, 0X00B4}, //acute accent
+	0XC3: {0X0302, 0X005E}, //circumflex accent
+	0XC4: {0X0303, 0X007E}, //tilde
+	0XC5: {0X0304, 0X00AF}, //macron
+	0XC6: {0X0306, 0X02D8}, //breve
+	0XC7: {0X0307, 0X02D9}, //dot above
+	0XC8: {0X0308, 0X00A8}, //diaeresis
+	0XC9: {0X0308, 0X00A8}, //umlaut (T.61)
+	0XCA: {0X030A, 0X02DA}, //ring above
+	0XCB: {0X0327, 0X00B8}, //cedilla
+	0XCC: {0X0332, 0X005F}, //non-spacing underline (T.61)
+	0XCD: {0X030B, 0X02DD}, //double acute accent
+	0XCE: {0X0328, 0X02DB}, //ogonek
+	0XCF: {0X030C, 0X02C7}, //caron
+}
+
+var (
+	//t61Encodings maps Unicode code points to the single octet encoding of T.61.
+	t61Encodings = make(map[rune]byte)
+
+	//t61CombiningEncodings maps combining characters to the non-spacing diacritical marks of T.61.
+	t61CombiningEncodings = make(map[rune]byte)
+
+	//t61SpacingEncodings maps spacing diacritical marks to the non-spacing diacritical marks of T.61,
+	//which are followed by SPACE to represent them.
+	t61SpacingEncodings = make(map[rune]byte)
+)
+
+//DecodeT61 decodes raw, which is a TeletexString encoded in T.61 (ISO/IEC 6937), to slices of runes.
+//A non-spacing diacritical mark followed by a base character is decoded into the base character followed by
+//the combining character, so Normalize composes them. A mark followed by SPACE is decoded into the spacing mark.
+//An InvalidEncodingError is returned if raw has an undefined code position or a mark which is not followed by a base character.
+func DecodeT61(raw []byte) ([]rune, error) {
+	dst := make([]rune, 0, len(raw))
+	for i := 0; i < len(raw); i++ {
+		c := raw[i]
+		if d, ok := t61Diacritics[c]; ok {
+			if i+1 >= len(raw) {
+				return nil, &InvalidEncodingError{Encoding: "T.61", Offset: i}
+			}
+			if raw[i+1] == ' ' {
+				dst = append(dst, d.spacing)
+				i++
+				continue
+			}
+			base, ok := decodeT61Character(raw[i+1])
+			if !ok || base < 0X20 || (base >= 0X7F && base <= 0XA0) {
+				return nil, &InvalidEncodingError{Encoding: "T.61", Offset: i}
+			}
+			dst = append(dst, base, d.combining)
+			i++
+			continue
+		}
+		r, ok := decodeT61Character(c)
+		if !ok {
+			return nil, &InvalidEncodingError{Encoding: "T.61", Offset: i}
+		}
+		dst = append(dst, r)
+	}
+	return dst, nil
+}
+
+//EncodeT61 encodes src to T.61 (ISO/IEC 6937).
+//A base character followed by a combining character is encoded as the non-spacing diacritical mark followed by the base character.
+//A character which is not defined by T.61 is encoded as its canonical decomposition,
+//a base character preceded by a non-spacing diacritical mark, if it is possible.
+//A spacing diacritical mark which is not defined by T.61 is encoded as the non-spacing mark followed by SPACE.
+//An error is returned if src has a character which cannot be encoded.
+func EncodeT61(src []rune) ([]byte, error) {
+	dst := make([]byte, 0, len(src))
+	for i := 0; i < len(src); i++ {
+		r := src[i]
+		if c, ok := t61Encodings[r]; ok {
+			//A base character followed by a combining character is encoded as the mark followed by the base character.
+			if mark, ok := t61CombiningEncodings[nextRune(src, i)]; ok && r != ' ' {
+				dst = append(dst, mark, c)
+				i++
+				continue
+			}
+			dst = append(dst, c)
+			continue
+		}
+		if mark, ok := t61SpacingEncodings[r]; ok {
+			dst = append(dst, mark, ' ')
+			continue
+		}
+		d := []rune(norm.NFD.String(string(r)))
+		if len(d) == 2 {
+			base, okBase := t61Encodings[d[0]]
+			mark, okMark := t61CombiningEncodings[d[1]]
+			if okBase && okMark {
+				dst = append(dst, mark, base)
+				continue
+			}
+		}
+		return nil, fmt.Errorf("ldapstrprep: %#U at index %d cannot be encoded in T.61", r, i)
+	}
+	return dst, nil
+}
+
+//decodeT61Character decodes the T.61 octet c which is not a non-spacing diacritical mark.
+//ok is false if c is an undefined code position.
+func decodeT61Character(c byte) (r rune, ok bool) {
+	if c < 0XA0 {
+		return rune(c), true
+	}
+	if r := t61UpperTable[c-0XA0]; r != t61Undefined {
+		return r, true
+	}
+	return 0, false
+}
+
+//nextRune returns the rune after the index i of src, or -1 if i is the last index.
+func nextRune(src []rune, i int) rune {
+	if i+1 < len(src) {
+		return src[i+1]
+	}
+	return -1
+}
+
+func init() {
+	for c := 0XFF; c >= 0; c-- {
+		if _, ok := t61Diacritics[byte(c)]; ok {
+			continue
+		}
+		if r, ok := decodeT61Character(byte(c)); ok {
+			//Lower code positions take precedence, such as 0X24 over 0XA4 for DOLLAR SIGN.
+			t61Encodings[r] = byte(c)
+		}
+	}
+	for c := 0XCF; c >= 0XC1; c-- {
+		//0XC8 takes precedence over 0XC9 for COMBINING DIAERESIS.
+		d := t61Diacritics[byte(c)]
+		t61CombiningEncodings[d.combining] = byte(c)
+		if _, ok := t61Encodings[d.spacing]; !ok {
+			t61SpacingEncodings[d.spacing] = byte(c)
+		}
+	}
+}
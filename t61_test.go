@@ -0,0 +1,122 @@
+package ldapstrprep
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestDecodeT61(t *testing.T) {
+	type args struct {
+		raw []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []rune
+		wantErr bool
+	}{
+		{"TestCase:ASCII", args{[]byte("Acme, Inc.")}, []rune("Acme, Inc."), false},
+		{"TestCase:empty", args{[]byte{}}, []rune{}, false},
+		{"TestCase:diaeresis", args{[]byte{'M', 0XC8, 'u', 'n', 'c', 'h', 'e', 'n'}}, []rune("Mu\U00000308nchen"), false},
+		{"TestCase:umlaut", args{[]byte{'M', 0XC9, 'u', 'n'}}, []rune("Mu\U00000308n"), false},
+		{"TestCase:acute and cedilla", args{[]byte{'F', 'r', 'a', 'n', 0XCB, 'c', 'o', 'i', 's', ' ', 0XC2, 'E', 't', 'e'}}, []rune("Franc\U00000327ois E\U00000301te"), false},
+		{"TestCase:caron on upper table base", args{[]byte{0XCF, 0XE9}}, []rune("\U000000D8\U0000030C"), false},
+		{"TestCase:spacing diacritical mark", args{[]byte{0XC2, ' ', 0XCF, ' '}}, []rune("\U000000B4\U000002C7"), false},
+		{"TestCase:upper table", args{[]byte{0XA4, 0XA6, 0XA8, 0XE0, 0XE1, 0XE8, 0XEA, 0XFB, 0XFF}}, []rune("$#\U000000A4\U00002126\U000000C6\U00000141\U00000152\U000000DF\U000000AD"), false},
+		{"TestCase:diacritical mark at end", args{[]byte{'a', 0XC1}}, nil, true},
+		{"TestCase:double diacritical marks", args{[]byte{0XC1, 0XC2, 'a'}}, nil, true},
+		{"TestCase:diacritical mark on control", args{[]byte{0XC1, 0X0A}}, nil, true},
+		{"TestCase:undefined 0XC0", args{[]byte{'a', 0XC0}}, nil, true},
+		{"TestCase:undefined 0XD8", args{[]byte{0XD8}}, nil, true},
+		{"TestCase:undefined 0XE5", args{[]byte{0XE5}}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeT61(tt.args.raw)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DecodeT61() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeT61() = %U, want %U", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeT61(t *testing.T) {
+	type args struct {
+		src []rune
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    []byte
+		wantErr bool
+	}{
+		{"TestCase:ASCII", args{[]rune("Acme, Inc.")}, []byte("Acme, Inc."), false},
+		{"TestCase:precomposed", args{[]rune("München")}, []byte{'M', 0XC8, 'u', 'n', 'c', 'h', 'e', 'n'}, false},
+		{"TestCase:combining sequence", args{[]rune("Mu\U00000308n")}, []byte{'M', 0XC8, 'u', 'n'}, false},
+		{"TestCase:upper table", args{[]rune("\U00002126\U00000141\U000000DF")}, []byte{0XE0, 0XE8, 0XFB}, false},
+		{"TestCase:spacing diacritical mark", args{[]rune("\U000000B4^")}, []byte{0XC2, ' ', '^'}, false},
+		{"TestCase:not defined", args{[]rune("日本")}, nil, true},
+		{"TestCase:two diacritical marks", args{[]rune("\U000001D6")}, nil, true},
+		{"TestCase:lone combining character", args{[]rune("\U00000301")}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EncodeT61(tt.args.src)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EncodeT61() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !bytes.Equal(got, tt.want) {
+				t.Errorf("EncodeT61() = % X, want % X", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestT61RoundTrip(t *testing.T) {
+	//Every defined single octet is decoded and encoded to itself, except alternative code positions.
+	alternatives := map[byte]byte{0XA4: '$', 0XA6: '#'}
+	for c := 0; c <= 0XFF; c++ {
+		if _, ok := t61Diacritics[byte(c)]; ok {
+			continue
+		}
+		r, err := DecodeT61([]byte{byte(c)})
+		if err != nil {
+			continue
+		}
+		want := byte(c)
+		if alt, ok := alternatives[want]; ok {
+			want = alt
+		}
+		if got, err := EncodeT61(r); err != nil || !bytes.Equal(got, []byte{want}) {
+			t.Errorf("EncodeT61(DecodeT61(0x%02X)) = % X, %v", c, got, err)
+		}
+	}
+	//Every diacritical mark followed by a letter survives decoding, normalization and encoding.
+	for c := 0XC1; c <= 0XCF; c++ {
+		if c == 0XC9 {
+			continue
+		}
+		for _, base := range []byte("AaEeOoUuZzYyCcGgSs") {
+			raw := []byte{byte(c), base}
+			r, err := DecodeT61(raw)
+			if err != nil {
+				t.Fatalf("DecodeT61(% X) error = %v", raw, err)
+			}
+			if got, err := EncodeT61(r); err != nil || !bytes.Equal(got, raw) {
+				t.Errorf("EncodeT61(DecodeT61(% X)) = % X, %v", raw, got, err)
+			}
+			composed := []rune(norm.NFC.String(string(r)))
+			if got, err := EncodeT61(composed); err != nil || !bytes.Equal(got, raw) {
+				t.Errorf("EncodeT61(NFC(DecodeT61(% X))) = % X, %v", raw, got, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package ldapstrprep
+
+//MatchWord reports whether attributeValue matches assertionValue by wordMatch.
+//Both values are prepared as values of caseIgnoreMatch and split into words, which are separated by spaces,
+//that is, SPACE (U+0020) code points followed by no combining marks.
+//They match if assertionValue is a word and it matches a word of attributeValue by caseIgnoreMatch.
+//An assertionValue which has no word or several words matches no attributeValue.
+//https://tools.ietf.org/html/rfc4517#section-4.2.38
+func MatchWord(attributeValue string, assertionValue string) (bool, error) {
+	attr, err := prepareWords(attributeValue)
+	if err != nil {
+		return false, err
+	}
+	assertion, err := prepareWords(assertionValue)
+	if err != nil {
+		return false, err
+	}
+	if len(assertion) != 1 {
+		return false, nil
+	}
+	return indexWords(attr, assertion) != -1, nil
+}
+
+//MatchKeyword reports whether attributeValue matches assertionValue by keywordMatch.
+//The keywords of attributeValue are its words, split as MatchWord does, and the sequences of its consecutive words.
+//So they match if the words of assertionValue match consecutive words of attributeValue by caseIgnoreMatch,
+//for example, "main street" matches "12 Main  Street" but not "Main 12 Street".
+//An assertionValue which has no word matches no attributeValue.
+//https://tools.ietf.org/html/rfc4517#section-4.2.21
+func MatchKeyword(attributeValue string, assertionValue string) (bool, error) {
+	attr, err := prepareWords(attributeValue)
+	if err != nil {
+		return false, err
+	}
+	assertion, err := prepareWords(assertionValue)
+	if err != nil {
+		return false, err
+	}
+	if len(assertion) == 0 {
+		return false, nil
+	}
+	return indexWords(attr, assertion) != -1, nil
+}
+
+//prepareWords prepares s as a value of caseIgnoreMatch and splits it into words.
+//https://tools.ietf.org/html/rfc4518#section-2.6.1
+func prepareWords(s string) ([][]rune, error) {
+	dst, err := Prepare(CaseIgnoreMatch, s)
+	if err != nil {
+		return nil, err
+	}
+	return splitToWords(dst), nil
+}
+
+//indexWords returns the index of the first sequence of words in src which is equal to sub, or -1 if there is none.
+func indexWords(src [][]rune, sub [][]rune) int {
+	for i := 0; i+len(sub) <= len(src); i++ {
+		j := 0
+		for j < len(sub) && compareRunes(src[i+j], sub[j]) == 0 {
+			j++
+		}
+		if j == len(sub) {
+			return i
+		}
+	}
+	return -1
+}
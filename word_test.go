@@ -0,0 +1,80 @@
+package ldapstrprep
+
+import (
+	"testing"
+)
+
+func TestMatchWord(t *testing.T) {
+	type args struct {
+		attributeValue string
+		assertionValue string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"TestCase:first word", args{"Research and Development", "research"}, true, false},
+		{"TestCase:last word", args{"Research and Development", "DEVELOPMENT"}, true, false},
+		{"TestCase:spaces", args{"  Research   and Development ", " and "}, true, false},
+		{"TestCase:part of a word", args{"Research and Development", "search"}, false, false},
+		{"TestCase:fullwidth", args{"\U0000FF32\U0000FF45\U0000FF53\U0000FF45\U0000FF41\U0000FF52\U0000FF43\U0000FF48 and Development", "research"}, true, false},
+		{"TestCase:space followed by combining mark", args{"a \U00000301b", "a"}, false, false},
+		{"TestCase:word with combining mark", args{"a \U00000301b c", "a \U00000301b"}, true, false},
+		{"TestCase:several words", args{"Research and Development", "research and"}, false, false},
+		{"TestCase:no word", args{"Research and Development", " "}, false, false},
+		{"TestCase:no word in attribute value", args{"", "research"}, false, false},
+		{"TestCase:prohibited attribute value", args{"a\U0000E000", "a"}, false, true},
+		{"TestCase:prohibited assertion value", args{"a", "a\U0000E000"}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchWord(tt.args.attributeValue, tt.args.assertionValue)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MatchWord() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MatchWord() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchKeyword(t *testing.T) {
+	type args struct {
+		attributeValue string
+		assertionValue string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    bool
+		wantErr bool
+	}{
+		{"TestCase:word", args{"12 Main  Street", "main"}, true, false},
+		{"TestCase:consecutive words", args{"12 Main  Street", "main street"}, true, false},
+		{"TestCase:all words", args{"12 Main  Street", " 12 MAIN STREET "}, true, false},
+		{"TestCase:words not consecutive", args{"Main 12 Street", "main street"}, false, false},
+		{"TestCase:words in other order", args{"12 Main  Street", "street main"}, false, false},
+		{"TestCase:more words than attribute value", args{"Main Street", "main street west"}, false, false},
+		{"TestCase:part of a word", args{"12 Main  Street", "mai"}, false, false},
+		{"TestCase:repeated first word", args{"a a b", "a b"}, true, false},
+		{"TestCase:no word", args{"12 Main  Street", ""}, false, false},
+		{"TestCase:prohibited attribute value", args{"a\U0000E000", "a"}, false, true},
+		{"TestCase:prohibited assertion value", args{"a", "a\U0000E000"}, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchKeyword(tt.args.attributeValue, tt.args.assertionValue)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MatchKeyword() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MatchKeyword() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package ldapstrprep
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+//PreparedName is an X.509 distinguished name whose attribute values are prepared by caseIgnoreMatch.
+//The relative distinguished names are in the order of the RDNSequence, from the root to the leaf,
+//and the attributes of each relative distinguished name are sorted.
+//https://tools.ietf.org/html/rfc5280#section-7.1
+type PreparedName [][]PreparedAttribute
+
+//PreparedAttribute is an AttributeTypeAndValue whose value is prepared.
+//Type is the dotted object identifier of the attribute type.
+//Value is the prepared value, or the number sign followed by the hexadecimal DER encoding of the value
+//if the value is not a string.
+type PreparedAttribute struct {
+	Type  string
+	Value string
+}
+
+//rawAttributeTypeAndValue is an AttributeTypeAndValue whose value keeps its ASN.1 tag.
+type rawAttributeTypeAndValue struct {
+	Type  asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+//rawRelativeDistinguishedNameSET is a RelativeDistinguishedName whose values keep their ASN.1 tags.
+type rawRelativeDistinguishedNameSET []rawAttributeTypeAndValue
+
+//PrepareRawName prepares der, which is the DER encoding of an X.509 Name, such as RawSubject and RawIssuer of
+//x509.Certificate. Each attribute value is transcoded by TranscodeASN1 according to its ASN.1 string type,
+//and prepared by caseIgnoreMatch. IA5String values are prepared as well.
+//https://tools.ietf.org/html/rfc5280#section-7.1
+func PrepareRawName(der []byte) (PreparedName, error) {
+	var seq []rawRelativeDistinguishedNameSET
+	rest, err := asn1.Unmarshal(der, &seq)
+	if err != nil {
+		return nil, fmt.Errorf("ldapstrprep: invalid Name: %v", err)
+	}
+	if len(rest) != 0 {
+		return nil, fmt.Errorf("ldapstrprep: invalid Name: trailing data")
+	}
+	dst := make(PreparedName, 0, len(seq))
+	for _, rdn := range seq {
+		prepared := make([]PreparedAttribute, 0, len(rdn))
+		for _, atv := range rdn {
+			v, err := prepareRawAttributeValue(atv.Value)
+			if err != nil {
+				return nil, fmt.Errorf("ldapstrprep: attribute %s: %w", atv.Type, err)
+			}
+			prepared = append(prepared, PreparedAttribute{Type: atv.Type.String(), Value: v})
+		}
+		dst = append(dst, sortAttributes(prepared))
+	}
+	return dst, nil
+}
+
+//PrepareRDNSequence prepares the attribute values of seq by caseIgnoreMatch.
+//String values are treated as UTF-8, because the ASN.1 string types of them are lost by encoding/asn1.
+//In particular, TeletexString values are not decoded by DecodeT61. Use PrepareRawName to transcode them.
+//https://tools.ietf.org/html/rfc5280#section-7.1
+func PrepareRDNSequence(seq pkix.RDNSequence) (PreparedName, error) {
+	dst := make(PreparedName, 0, len(seq))
+	for _, rdn := range seq {
+		prepared := make([]PreparedAttribute, 0, len(rdn))
+		for _, atv := range rdn {
+			v, err := prepareAttributeValue(atv.Value)
+			if err != nil {
+				return nil, fmt.Errorf("ldapstrprep: attribute %s: %w", atv.Type, err)
+			}
+			prepared = append(prepared, PreparedAttribute{Type: atv.Type.String(), Value: v})
+		}
+		dst = append(dst, sortAttributes(prepared))
+	}
+	return dst, nil
+}
+
+//PrepareName prepares the attribute values of name by caseIgnoreMatch. See PrepareRDNSequence.
+//If name is parsed, such as Subject and Issuer of x509.Certificate, its Names are prepared in the order of the
+//encoding, because name.ToRDNSequence drops the attributes which have no field, such as domainComponent, and
+//reorders the others. Each of Names is a relative distinguished name of its own, since pkix.Name does not keep
+//multi-valued relative distinguished names. Otherwise, name.ToRDNSequence is prepared.
+//PrepareRawName of RawSubject and RawIssuer is exact, and should be used to compare the names of certificates.
+func PrepareName(name pkix.Name) (PreparedName, error) {
+	if len(name.Names) == 0 {
+		return PrepareRDNSequence(name.ToRDNSequence())
+	}
+	seq := make(pkix.RDNSequence, 0, len(name.Names))
+	for _, atv := range name.Names {
+		seq = append(seq, pkix.RelativeDistinguishedNameSET{atv})
+	}
+	return PrepareRDNSequence(seq)
+}
+
+//Equal reports whether n and m are the same distinguished name.
+func (n PreparedName) Equal(m PreparedName) bool {
+	if len(n) != len(m) {
+		return false
+	}
+	for i := range n {
+		if !equalRDN(n[i], m[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+//String returns the canonical form of n, which is equal to the canonical form of another name
+//if and only if the names are equal. It is suitable for a map key in chain building.
+//The relative distinguished names are separated by commas in the order of n, and the attributes of
+//a relative distinguished name are separated by plus signs. Backslashes, commas and plus signs in values are escaped.
+func (n PreparedName) String() string {
+	var b strings.Builder
+	for i, rdn := range n {
+		if i != 0 {
+			b.WriteByte(',')
+		}
+		for j, atv := range rdn {
+			if j != 0 {
+				b.WriteByte('+')
+			}
+			b.WriteString(atv.Type)
+			b.WriteByte('=')
+			for k := 0; k < len(atv.Value); k++ {
+				if c := atv.Value[k]; c == '\\' || c == ',' || c == '+' {
+					b.WriteByte('\\')
+				}
+				b.WriteByte(atv.Value[k])
+			}
+		}
+	}
+	return b.String()
+}
+
+//equalRDN reports whether the sorted relative distinguished names a and b are equal.
+func equalRDN(a []PreparedAttribute, b []PreparedAttribute) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+//prepareRawAttributeValue prepares v by caseIgnoreMatch, after transcoding it by its ASN.1 string type.
+func prepareRawAttributeValue(v asn1.RawValue) (string, error) {
+	if v.Class != asn1.ClassUniversal {
+		return "#" + hex.EncodeToString(v.FullBytes), nil
+	}
+	var src []rune
+	var err error
+	switch v.Tag {
+	case asn1.TagIA5String:
+		if err = ValidateIA5String(string(v.Bytes)); err != nil {
+			return "", err
+		}
+		src = []rune(string(v.Bytes))
+	case asn1.TagUTF8String, asn1.TagPrintableString, asn1.TagT61String, asn1.TagBMPString, tagUniversalString:
+		if src, err = TranscodeASN1(v.Tag, v.Bytes); err != nil {
+			return "", err
+		}
+	default:
+		return "#" + hex.EncodeToString(v.FullBytes), nil
+	}
+	return prepareCaseIgnore(src)
+}
+
+//prepareAttributeValue prepares v, which is a value decoded by encoding/asn1, by caseIgnoreMatch.
+func prepareAttributeValue(v interface{}) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		der, err := asn1.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return "#" + hex.EncodeToString(der), nil
+	}
+	src, err := TranscodeStrict(s)
+	if err != nil {
+		return "", err
+	}
+	return prepareCaseIgnore(src)
+}
+
+//prepareCaseIgnore applies the string preparation steps of caseIgnoreMatch after Transcode to src.
+func prepareCaseIgnore(src []rune) (string, error) {
+	dst := Normalize(MapCharacters(src, true))
+	if _, err := IsProhibited(dst); err != nil {
+		return "", err
+	}
+	return string(ApplyInsignificantSpaceHandling(dst)), nil
+}
+
+//sortAttributes sorts the attributes of a relative distinguished name by their types and values.
+func sortAttributes(rdn []PreparedAttribute) []PreparedAttribute {
+	sort.Slice(rdn, func(i, j int) bool {
+		if rdn[i].Type != rdn[j].Type {
+			return rdn[i].Type < rdn[j].Type
+		}
+		return rdn[i].Value < rdn[j].Value
+	})
+	return rdn
+}
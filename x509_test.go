@@ -0,0 +1,193 @@
+package ldapstrprep
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"reflect"
+	"testing"
+)
+
+var (
+	oidDomainComponent    = asn1.ObjectIdentifier{0, 9, 2342, 19200300, 100, 1, 25}
+	oidCountry            = asn1.ObjectIdentifier{2, 5, 4, 6}
+	oidOrganization       = asn1.ObjectIdentifier{2, 5, 4, 10}
+	oidOrganizationalUnit = asn1.ObjectIdentifier{2, 5, 4, 11}
+	oidCommonName         = asn1.ObjectIdentifier{2, 5, 4, 3}
+	oidEmailAddress       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 1}
+)
+
+//rawATV returns an AttributeTypeAndValue whose value is encoded with the universal tag.
+func rawATV(oid asn1.ObjectIdentifier, tag int, b []byte) rawAttributeTypeAndValue {
+	return rawAttributeTypeAndValue{Type: oid, Value: asn1.RawValue{Class: asn1.ClassUniversal, Tag: tag, Bytes: b}}
+}
+
+//parsedName returns the pkix.Name filled from seq as crypto/x509 parses a certificate.
+func parsedName(seq pkix.RDNSequence) pkix.Name {
+	var name pkix.Name
+	name.FillFromRDNSequence(&seq)
+	return name
+}
+
+//marshalRawName returns the DER encoding of the Name which consists of rdns.
+func marshalRawName(rdns ...rawRelativeDistinguishedNameSET) []byte {
+	der, err := asn1.Marshal(rdns)
+	if err != nil {
+		panic(err)
+	}
+	return der
+}
+
+//marshalRawATV returns the DER encoding of the Name which consists of an AttributeTypeAndValue.
+func marshalRawATV(oid asn1.ObjectIdentifier, tag int, b []byte) []byte {
+	return marshalRawName(rawRelativeDistinguishedNameSET{rawATV(oid, tag, b)})
+}
+
+func TestPrepareRawName(t *testing.T) {
+	type args struct {
+		der []byte
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    PreparedName
+		wantErr bool
+	}{
+		{"TestCase:PrintableString and UTF8String", args{marshalRawName(
+			rawRelativeDistinguishedNameSET{rawATV(oidCountry, asn1.TagPrintableString, []byte("JP"))},
+			rawRelativeDistinguishedNameSET{rawATV(oidCommonName, asn1.TagUTF8String, []byte("  Foo   Bar "))},
+		)}, PreparedName{{{Type: "2.5.4.6", Value: " jp "}}, {{Type: "2.5.4.3", Value: " foo  bar "}}}, false},
+		{"TestCase:BMPString", args{marshalRawATV(oidCommonName, asn1.TagBMPString, []byte{0X00, 0X41, 0X00, 0XC5})}, PreparedName{{{Type: "2.5.4.3", Value: " a\U000000E5 "}}}, false},
+		{"TestCase:TeletexString with a diacritical mark", args{marshalRawATV(oidCommonName, asn1.TagT61String, []byte{'A', 0XCA, 'A'})}, PreparedName{{{Type: "2.5.4.3", Value: " a\U000000E5 "}}}, false},
+		{"TestCase:UniversalString", args{marshalRawATV(oidCommonName, tagUniversalString, []byte{0, 0, 0, 'A', 0, 0, 0, 'b'})}, PreparedName{{{Type: "2.5.4.3", Value: " ab "}}}, false},
+		{"TestCase:IA5String", args{marshalRawATV(oidEmailAddress, asn1.TagIA5String, []byte("Foo@Example.COM"))}, PreparedName{{{Type: "1.2.840.113549.1.9.1", Value: " foo@example.com "}}}, false},
+		{"TestCase:Multi-valued RDN is sorted", args{marshalRawName(rawRelativeDistinguishedNameSET{
+			rawATV(oidOrganizationalUnit, asn1.TagUTF8String, []byte("B")),
+			rawATV(oidCommonName, asn1.TagUTF8String, []byte("A")),
+		})}, PreparedName{{{Type: "2.5.4.11", Value: " b "}, {Type: "2.5.4.3", Value: " a "}}}, false},
+		{"TestCase:Non-string value", args{marshalRawATV(oidCommonName, asn1.TagInteger, []byte{0X01})}, PreparedName{{{Type: "2.5.4.3", Value: "#020101"}}}, false},
+		{"TestCase:Invalid PrintableString", args{marshalRawATV(oidCountry, asn1.TagPrintableString, []byte("J@"))}, nil, true},
+		{"TestCase:Invalid IA5String", args{marshalRawATV(oidEmailAddress, asn1.TagIA5String, []byte{0XE9})}, nil, true},
+		{"TestCase:Prohibited character", args{marshalRawATV(oidCommonName, asn1.TagUTF8String, []byte("a\U0000FFFDb"))}, nil, true},
+		{"TestCase:Not a Name", args{[]byte{0X04, 0X01, 0X00}}, nil, true},
+		{"TestCase:Trailing data", args{append(marshalRawName(), 0X00)}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PrepareRawName(tt.args.der)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PrepareRawName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PrepareRawName() got = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrepareName(t *testing.T) {
+	type args struct {
+		name pkix.Name
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    string
+		wantErr bool
+	}{
+		{"TestCase:Country, organization and common name", args{pkix.Name{Country: []string{"JP"}, Organization: []string{"Example  Inc."}, CommonName: "Foo"}}, "2.5.4.6= jp ,2.5.4.10= example  inc. ,2.5.4.3= foo ", false},
+		{"TestCase:Escaped characters", args{pkix.Name{CommonName: `a,b+c\d`}}, `2.5.4.3= a\,b\+c\\d `, false},
+		{"TestCase:Invalid UTF-8", args{pkix.Name{CommonName: "\xFF"}}, "", true},
+		{"TestCase:Parsed name in the order of the encoding", args{parsedName(pkix.RDNSequence{
+			{{Type: oidCommonName, Value: "Foo"}},
+			{{Type: oidDomainComponent, Value: "Example"}},
+			{{Type: oidCountry, Value: "JP"}},
+		})}, "2.5.4.3= foo ,0.9.2342.19200300.100.1.25= example ,2.5.4.6= jp ", false},
+		{"TestCase:Parsed multi-valued RDN", args{parsedName(pkix.RDNSequence{
+			{{Type: oidCommonName, Value: "Foo"}, {Type: oidOrganizationalUnit, Value: "Bar"}},
+		})}, "2.5.4.3= foo ,2.5.4.11= bar ", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PrepareName(tt.args.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PrepareName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if err != nil {
+				return
+			}
+			if got.String() != tt.want {
+				t.Errorf("PrepareName() got = %q, want %q", got.String(), tt.want)
+			}
+		})
+	}
+	t.Run("TestCase:Names which differ only in domainComponent", func(t *testing.T) {
+		evil, err := PrepareName(parsedName(pkix.RDNSequence{
+			{{Type: oidCommonName, Value: "x"}}, {{Type: oidDomainComponent, Value: "evil"}}, {{Type: oidDomainComponent, Value: "com"}},
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		good, err := PrepareName(parsedName(pkix.RDNSequence{
+			{{Type: oidCommonName, Value: "x"}}, {{Type: oidDomainComponent, Value: "good"}}, {{Type: oidDomainComponent, Value: "com"}},
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if evil.Equal(good) {
+			t.Errorf("PrepareName() %v is equal to %v", evil, good)
+		}
+	})
+}
+
+func TestPreparedName_Equal(t *testing.T) {
+	type args struct {
+		a pkix.RDNSequence
+		b pkix.RDNSequence
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{"TestCase:Case and spaces are insignificant", args{
+			pkix.RDNSequence{{{Type: oidCountry, Value: "JP"}}, {{Type: oidCommonName, Value: "Foo  Bar"}}},
+			pkix.RDNSequence{{{Type: oidCountry, Value: "jp"}}, {{Type: oidCommonName, Value: " FOO BAR "}}},
+		}, true},
+		{"TestCase:Order of multi-valued RDN is insignificant", args{
+			pkix.RDNSequence{{{Type: oidCommonName, Value: "a"}, {Type: oidOrganizationalUnit, Value: "b"}}},
+			pkix.RDNSequence{{{Type: oidOrganizationalUnit, Value: "B"}, {Type: oidCommonName, Value: "A"}}},
+		}, true},
+		{"TestCase:Order of RDNs is significant", args{
+			pkix.RDNSequence{{{Type: oidCountry, Value: "JP"}}, {{Type: oidOrganization, Value: "JP"}}},
+			pkix.RDNSequence{{{Type: oidOrganization, Value: "JP"}}, {{Type: oidCountry, Value: "JP"}}},
+		}, false},
+		{"TestCase:Different lengths", args{
+			pkix.RDNSequence{{{Type: oidCountry, Value: "JP"}}},
+			pkix.RDNSequence{{{Type: oidCountry, Value: "JP"}}, {{Type: oidCommonName, Value: "Foo"}}},
+		}, false},
+		{"TestCase:Different values", args{
+			pkix.RDNSequence{{{Type: oidCommonName, Value: "Foo"}}},
+			pkix.RDNSequence{{{Type: oidCommonName, Value: "Fob"}}},
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := PrepareRDNSequence(tt.args.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := PrepareRDNSequence(tt.args.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := a.Equal(b); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+			if got := a.String() == b.String(); got != tt.want {
+				t.Errorf("String() equality = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}